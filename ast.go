@@ -1,5 +1,10 @@
 package z3
 
+import (
+	"math/big"
+	"unsafe"
+)
+
 // #include <stdlib.h>
 // #include "go-z3.h"
 import "C"
@@ -20,6 +25,9 @@ func (a *AST) String() string {
 
 // DeclName returns the name of a declaration. The AST value must be a
 // func declaration for this to work.
+//
+// Deprecated: declarations are represented by FuncDecl. Use
+// FuncDecl.Name instead.
 func (a *AST) DeclName() *Symbol {
 	return &Symbol{
 		rawCtx: a.rawCtx,
@@ -53,6 +61,45 @@ func (c *Context) Int(v int, typ *Sort) *AST {
 	}
 }
 
+// Real creates a real value from the fraction num/den. den must not
+// be zero. Z3 only accepts 32-bit integers here, so a num or den that
+// doesn't fit is built through Rat instead.
+//
+// Maps: Z3_mk_real
+func (c *Context) Real(num, den int) *AST {
+	if den != 0 && (int(C.int(num)) != num || int(C.int(den)) != den) {
+		return c.Rat(big.NewRat(int64(num), int64(den)))
+	}
+
+	return &AST{
+		rawCtx: c.raw,
+		rawAST: C.Z3_mk_real(c.raw, C.int(num), C.int(den)),
+	}
+}
+
+// Rat creates an exact real value from a big.Rat. Unlike Real, this
+// is not limited to machine integers for the numerator and denominator.
+//
+// Maps: Z3_mk_numeral
+func (c *Context) Rat(v *big.Rat) *AST {
+	return c.Numeral(v.RatString(), c.RealSort())
+}
+
+// Numeral creates a numeral of the given type from a string. The string
+// may be an integer ("42"), a fraction ("-3/4") or a decimal ("0.25").
+// Fractions and decimals are only valid for the real type.
+//
+// Maps: Z3_mk_numeral
+func (c *Context) Numeral(v string, typ *Sort) *AST {
+	ns := C.CString(v)
+	defer C.free(unsafe.Pointer(ns))
+
+	return &AST{
+		rawCtx: c.raw,
+		rawAST: C.Z3_mk_numeral(c.raw, ns, typ.rawSort),
+	}
+}
+
 // True creates the value "true".
 //
 // Maps: Z3_mk_true
@@ -84,3 +131,175 @@ func (a *AST) Int() int {
 	C.Z3_get_numeral_int(a.rawCtx, a.rawAST, &dst)
 	return int(dst)
 }
+
+// Rat gets the exact rational value of this AST. This works for both
+// integer and real numerals and is the lossless alternative to Int. This
+// will return nil if the AST is not a numeral.
+//
+// Maps: Z3_get_numeral_string
+func (a *AST) Rat() *big.Rat {
+	if !bool(C.Z3_is_numeral_ast(a.rawCtx, a.rawAST)) {
+		return nil
+	}
+
+	// Z3 formats rationals as "p/q" (or just "p"), which is the same
+	// format SetString accepts, so no further parsing is needed.
+	v, ok := new(big.Rat).SetString(
+		C.GoString(C.Z3_get_numeral_string(a.rawCtx, a.rawAST)))
+	if !ok {
+		return nil
+	}
+
+	return v
+}
+
+//-------------------------------------------------------------------
+// Introspection
+//-------------------------------------------------------------------
+
+// Kind returns the kind of the AST node.
+//
+// Maps: Z3_get_ast_kind
+func (a *AST) Kind() ASTKind {
+	return ASTKind(C.Z3_get_ast_kind(a.rawCtx, a.rawAST))
+}
+
+// Sort returns the type of the AST. The AST must be an expression, that
+// is a numeral, an application, a bound variable or a quantifier.
+//
+// Maps: Z3_get_sort
+func (a *AST) Sort() *Sort {
+	return &Sort{
+		rawCtx:  a.rawCtx,
+		rawSort: C.Z3_get_sort(a.rawCtx, a.rawAST),
+	}
+}
+
+// IsApp returns true if the AST is a function application. Constants
+// and numerals are applications with no arguments.
+//
+// Maps: Z3_is_app
+func (a *AST) IsApp() bool {
+	return bool(C.Z3_is_app(a.rawCtx, a.rawAST))
+}
+
+// IsNumeral returns true if the AST is a numeral of any type, such as an
+// integer, a real or a bit-vector.
+//
+// Maps: Z3_is_numeral_ast
+func (a *AST) IsNumeral() bool {
+	return bool(C.Z3_is_numeral_ast(a.rawCtx, a.rawAST))
+}
+
+// Decl returns the declaration of the function the AST applies. This
+// will return nil if the AST isn't an application.
+//
+// Maps: Z3_get_app_decl
+func (a *AST) Decl() *FuncDecl {
+	if !a.IsApp() {
+		return nil
+	}
+
+	return &FuncDecl{
+		rawCtx:      a.rawCtx,
+		rawFuncDecl: C.Z3_get_app_decl(a.rawCtx, C.Z3_to_app(a.rawCtx, a.rawAST)),
+	}
+}
+
+// NumArgs returns the number of arguments of the application. This is
+// zero if the AST isn't an application.
+//
+// Maps: Z3_get_app_num_args
+func (a *AST) NumArgs() uint {
+	if !a.IsApp() {
+		return 0
+	}
+
+	return uint(C.Z3_get_app_num_args(a.rawCtx, C.Z3_to_app(a.rawCtx, a.rawAST)))
+}
+
+// Arg returns the argument of the application at the given index, which
+// must be less than NumArgs.
+//
+// Maps: Z3_get_app_arg
+func (a *AST) Arg(i uint) *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_get_app_arg(a.rawCtx, C.Z3_to_app(a.rawCtx, a.rawAST), C.uint(i)),
+	}
+}
+
+// Args returns all the arguments of the application, or nil if the AST
+// isn't an application.
+//
+// This doesn't map to any specific Z3 API. It calls Arg for every index
+// up to NumArgs.
+func (a *AST) Args() []*AST {
+	n := a.NumArgs()
+	if n == 0 {
+		return nil
+	}
+
+	result := make([]*AST, n)
+	for i := range result {
+		result[i] = a.Arg(uint(i))
+	}
+
+	return result
+}
+
+// VarIndex returns the de-Bruijn index of a bound variable. The AST must
+// be of kind ASTVar.
+//
+// Maps: Z3_get_index_value
+func (a *AST) VarIndex() uint {
+	return uint(C.Z3_get_index_value(a.rawCtx, a.rawAST))
+}
+
+// Equal returns true if a and a2 are the same term. Z3 shares
+// structurally equal nodes, so this compares them in constant time. Use
+// Eq to build a formula stating that two terms are equal instead.
+//
+// Maps: Z3_is_eq_ast
+func (a *AST) Equal(a2 *AST) bool {
+	return bool(C.Z3_is_eq_ast(a.rawCtx, a.rawAST, a2.rawAST))
+}
+
+// ID returns the unique identifier of the AST node within its Context.
+// Z3 shares structurally equal nodes, so two ASTs with the same ID are
+// the same term.
+//
+// Maps: Z3_get_ast_id
+func (a *AST) ID() uint {
+	return uint(C.Z3_get_ast_id(a.rawCtx, a.rawAST))
+}
+
+// Hash returns a structural hash of the AST node.
+//
+// Maps: Z3_get_ast_hash
+func (a *AST) Hash() uint {
+	return uint(C.Z3_get_ast_hash(a.rawCtx, a.rawAST))
+}
+
+//-------------------------------------------------------------------
+// Helpers
+//-------------------------------------------------------------------
+
+// astVectorToSlice converts a Z3 AST vector into a slice of ASTs. The
+// vector should be freshly returned from Z3; it is freed once the
+// conversion completes.
+func astVectorToSlice(rawCtx C.Z3_context, v C.Z3_ast_vector) []*AST {
+	C.Z3_ast_vector_inc_ref(rawCtx, v)
+	defer C.Z3_ast_vector_dec_ref(rawCtx, v)
+
+	n := C.Z3_ast_vector_size(rawCtx, v)
+	result := make([]*AST, n)
+	for i := C.uint(0); i < n; i++ {
+		result[i] = &AST{
+			rawCtx: rawCtx,
+			rawAST: C.Z3_ast_vector_get(rawCtx, v, i),
+		}
+	}
+
+	return result
+}
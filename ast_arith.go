@@ -103,3 +103,44 @@ func (a *AST) Ge(a2 *AST) *AST {
 		rawAST: C.Z3_mk_ge(a.rawCtx, a.rawAST, a2.rawAST),
 	}
 }
+
+// Div creates an AST node representing a / a2. For integers this is
+// integer division, for reals it is exact division.
+//
+// Maps to: Z3_mk_div
+func (a *AST) Div(a2 *AST) *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_div(a.rawCtx, a.rawAST, a2.rawAST),
+	}
+}
+
+// ToReal coerces an integer AST into a real.
+//
+// Maps to: Z3_mk_int2real
+func (a *AST) ToReal() *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_int2real(a.rawCtx, a.rawAST),
+	}
+}
+
+// ToInt coerces a real AST into an integer by taking the floor.
+//
+// Maps to: Z3_mk_real2int
+func (a *AST) ToInt() *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_real2int(a.rawCtx, a.rawAST),
+	}
+}
+
+// IsInt creates an AST node that checks if a real AST is an integer.
+//
+// Maps to: Z3_mk_is_int
+func (a *AST) IsInt() *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_is_int(a.rawCtx, a.rawAST),
+	}
+}
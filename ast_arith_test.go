@@ -142,3 +142,22 @@ func TestASTGe(t *testing.T) {
 		t.Fatalf("bad:\n%s", actual)
 	}
 }
+
+func TestASTDiv(t *testing.T) {
+	config := NewConfig()
+	defer config.Close()
+	ctx := NewContext(config)
+	defer ctx.Close()
+
+	// Create a real
+	x := ctx.Const(ctx.Symbol("x"), ctx.RealSort())
+	y := ctx.Real(1, 3)
+
+	// Div
+	raw := x.Div(y)
+
+	actual := raw.String()
+	if actual != "(/ x (/ 1.0 3.0))" {
+		t.Fatalf("bad:\n%s", actual)
+	}
+}
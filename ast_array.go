@@ -0,0 +1,78 @@
+package z3
+
+import (
+	"unsafe"
+)
+
+// #include "go-z3.h"
+import "C"
+
+// Select creates an AST node representing the value of the array a at
+// index i.
+//
+// Maps to: Z3_mk_select
+func (a *AST) Select(i *AST) *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_select(a.rawCtx, a.rawAST, i.rawAST),
+	}
+}
+
+// Store creates an AST node representing the array a with the value at
+// index i replaced by v.
+//
+// Maps to: Z3_mk_store
+func (a *AST) Store(i, v *AST) *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_store(a.rawCtx, a.rawAST, i.rawAST, v.rawAST),
+	}
+}
+
+// ArrayDefault creates an AST node representing the default value of
+// the array a, the value of every index that was never stored to.
+//
+// Maps to: Z3_mk_array_default
+func (a *AST) ArrayDefault() *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_array_default(a.rawCtx, a.rawAST),
+	}
+}
+
+// ConstArray creates an array with the given domain where every index
+// maps to v.
+//
+// Maps: Z3_mk_const_array
+func (c *Context) ConstArray(domain *Sort, v *AST) *AST {
+	return &AST{
+		rawCtx: c.raw,
+		rawAST: C.Z3_mk_const_array(c.raw, domain.rawSort, v.rawAST),
+	}
+}
+
+// ArrayMap creates an array whose value at every index i is the function
+// f applied to the values of args at i. The number of args must match the
+// arity of f.
+//
+// Maps: Z3_mk_map
+func (c *Context) ArrayMap(f *FuncDecl, args ...*AST) *AST {
+	raws := make([]C.Z3_ast, len(args))
+	for i, arg := range args {
+		raws[i] = arg.rawAST
+	}
+
+	var rawArgs *C.Z3_ast
+	if len(raws) > 0 {
+		rawArgs = (*C.Z3_ast)(unsafe.Pointer(&raws[0]))
+	}
+
+	return &AST{
+		rawCtx: c.raw,
+		rawAST: C.Z3_mk_map(
+			c.raw,
+			f.rawFuncDecl,
+			C.uint(len(raws)),
+			rawArgs),
+	}
+}
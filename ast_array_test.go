@@ -0,0 +1,62 @@
+package z3
+
+import (
+	"testing"
+)
+
+func TestASTSelect(t *testing.T) {
+	config := NewConfig()
+	defer config.Close()
+	ctx := NewContext(config)
+	defer ctx.Close()
+
+	// Create an array
+	a := ctx.Const(ctx.Symbol("a"), ctx.ArraySort(ctx.IntSort(), ctx.BoolSort()))
+	i := ctx.Const(ctx.Symbol("i"), ctx.IntSort())
+
+	raw := a.Select(i)
+
+	actual := raw.String()
+	if actual != "(select a i)" {
+		t.Fatalf("bad:\n%s", actual)
+	}
+}
+
+func TestASTStore(t *testing.T) {
+	config := NewConfig()
+	defer config.Close()
+	ctx := NewContext(config)
+	defer ctx.Close()
+
+	// Create an array
+	a := ctx.Const(ctx.Symbol("a"), ctx.ArraySort(ctx.IntSort(), ctx.BoolSort()))
+	i := ctx.Const(ctx.Symbol("i"), ctx.IntSort())
+
+	raw := a.Store(i, ctx.True())
+
+	actual := raw.String()
+	if actual != "(store a i true)" {
+		t.Fatalf("bad:\n%s", actual)
+	}
+}
+
+func TestConstArray(t *testing.T) {
+	config := NewConfig()
+	defer config.Close()
+	ctx := NewContext(config)
+	defer ctx.Close()
+
+	// Create the solver
+	s := ctx.NewSolver()
+	defer s.Close()
+
+	// Every index of a constant array has the same value
+	a := ctx.ConstArray(ctx.IntSort(), ctx.Int(7, ctx.IntSort()))
+	i := ctx.Const(ctx.Symbol("i"), ctx.IntSort())
+	s.Assert(a.Select(i).Eq(ctx.Int(7, ctx.IntSort())).Not())
+	s.Assert(a.ArrayDefault().Eq(ctx.Int(7, ctx.IntSort())))
+
+	if v := s.Check(); v != False {
+		t.Fatalf("bad: %v", v)
+	}
+}
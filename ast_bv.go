@@ -0,0 +1,648 @@
+package z3
+
+import (
+	"math/big"
+	"unsafe"
+)
+
+// #include <stdlib.h>
+// #include "go-z3.h"
+import "C"
+
+//-------------------------------------------------------------------
+// Bit-vector Literals
+//-------------------------------------------------------------------
+
+// BitVec creates a bit-vector value of the given size from a uint64.
+// Bits of v that don't fit within size are discarded.
+//
+// Maps: Z3_mk_unsigned_int64
+func (c *Context) BitVec(v uint64, size uint) *AST {
+	return &AST{
+		rawCtx: c.raw,
+		rawAST: C.Z3_mk_unsigned_int64(
+			c.raw, C.uint64_t(v), c.BitVecSort(size).rawSort),
+	}
+}
+
+// BitVecBig creates a bit-vector value of the given size from a big.Int.
+// Negative values are encoded in two's complement and bits that don't
+// fit within size are discarded.
+//
+// Maps: Z3_mk_numeral
+func (c *Context) BitVecBig(v *big.Int, size uint) *AST {
+	// Reduce modulo 2^size so Z3 always receives a canonical
+	// non-negative value regardless of the sign of v.
+	mod := new(big.Int).Lsh(big.NewInt(1), size)
+	v = new(big.Int).Mod(v, mod)
+
+	ns := C.CString(v.String())
+	defer C.free(unsafe.Pointer(ns))
+
+	return &AST{
+		rawCtx: c.raw,
+		rawAST: C.Z3_mk_numeral(c.raw, ns, c.BitVecSort(size).rawSort),
+	}
+}
+
+//-------------------------------------------------------------------
+// Value Readers
+//-------------------------------------------------------------------
+
+// Uint64 gets the unsigned value of this AST. The value must be a
+// numeral that fits into 64 bits. The second return value is false if
+// the value could not be read.
+//
+// Maps: Z3_get_numeral_uint64
+func (a *AST) Uint64() (uint64, bool) {
+	var dst C.uint64_t
+	if !bool(C.Z3_get_numeral_uint64(a.rawCtx, a.rawAST, &dst)) {
+		return 0, false
+	}
+
+	return uint64(dst), true
+}
+
+// BigInt gets the value of this AST as a big.Int. This works for
+// integer and bit-vector numerals of any size. Bit-vectors are read as
+// unsigned values. This will return nil if the AST is not an integral
+// numeral.
+//
+// Maps: Z3_get_numeral_string
+func (a *AST) BigInt() *big.Int {
+	if !bool(C.Z3_is_numeral_ast(a.rawCtx, a.rawAST)) {
+		return nil
+	}
+
+	v, ok := new(big.Int).SetString(
+		C.GoString(C.Z3_get_numeral_string(a.rawCtx, a.rawAST)), 10)
+	if !ok {
+		return nil
+	}
+
+	return v
+}
+
+//-------------------------------------------------------------------
+// Arithmetic and Bitwise Operations
+//-------------------------------------------------------------------
+
+// BVAdd creates an AST node representing two's complement addition.
+//
+// a and a2 must be bit-vectors of the same size.
+//
+// Maps to: Z3_mk_bvadd
+func (a *AST) BVAdd(a2 *AST) *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_bvadd(a.rawCtx, a.rawAST, a2.rawAST),
+	}
+}
+
+// BVSub creates an AST node representing two's complement subtraction.
+//
+// a and a2 must be bit-vectors of the same size.
+//
+// Maps to: Z3_mk_bvsub
+func (a *AST) BVSub(a2 *AST) *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_bvsub(a.rawCtx, a.rawAST, a2.rawAST),
+	}
+}
+
+// BVMul creates an AST node representing two's complement multiplication.
+//
+// a and a2 must be bit-vectors of the same size.
+//
+// Maps to: Z3_mk_bvmul
+func (a *AST) BVMul(a2 *AST) *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_bvmul(a.rawCtx, a.rawAST, a2.rawAST),
+	}
+}
+
+// BVUDiv creates an AST node representing unsigned division.
+//
+// a and a2 must be bit-vectors of the same size.
+//
+// Maps to: Z3_mk_bvudiv
+func (a *AST) BVUDiv(a2 *AST) *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_bvudiv(a.rawCtx, a.rawAST, a2.rawAST),
+	}
+}
+
+// BVSDiv creates an AST node representing signed division.
+//
+// a and a2 must be bit-vectors of the same size.
+//
+// Maps to: Z3_mk_bvsdiv
+func (a *AST) BVSDiv(a2 *AST) *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_bvsdiv(a.rawCtx, a.rawAST, a2.rawAST),
+	}
+}
+
+// BVURem creates an AST node representing unsigned remainder.
+//
+// a and a2 must be bit-vectors of the same size.
+//
+// Maps to: Z3_mk_bvurem
+func (a *AST) BVURem(a2 *AST) *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_bvurem(a.rawCtx, a.rawAST, a2.rawAST),
+	}
+}
+
+// BVSRem creates an AST node representing signed remainder where the
+// sign follows the dividend.
+//
+// a and a2 must be bit-vectors of the same size.
+//
+// Maps to: Z3_mk_bvsrem
+func (a *AST) BVSRem(a2 *AST) *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_bvsrem(a.rawCtx, a.rawAST, a2.rawAST),
+	}
+}
+
+// BVSMod creates an AST node representing signed remainder where the
+// sign follows the divisor.
+//
+// a and a2 must be bit-vectors of the same size.
+//
+// Maps to: Z3_mk_bvsmod
+func (a *AST) BVSMod(a2 *AST) *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_bvsmod(a.rawCtx, a.rawAST, a2.rawAST),
+	}
+}
+
+// BVAnd creates an AST node representing bitwise and.
+//
+// a and a2 must be bit-vectors of the same size.
+//
+// Maps to: Z3_mk_bvand
+func (a *AST) BVAnd(a2 *AST) *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_bvand(a.rawCtx, a.rawAST, a2.rawAST),
+	}
+}
+
+// BVOr creates an AST node representing bitwise or.
+//
+// a and a2 must be bit-vectors of the same size.
+//
+// Maps to: Z3_mk_bvor
+func (a *AST) BVOr(a2 *AST) *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_bvor(a.rawCtx, a.rawAST, a2.rawAST),
+	}
+}
+
+// BVXor creates an AST node representing bitwise xor.
+//
+// a and a2 must be bit-vectors of the same size.
+//
+// Maps to: Z3_mk_bvxor
+func (a *AST) BVXor(a2 *AST) *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_bvxor(a.rawCtx, a.rawAST, a2.rawAST),
+	}
+}
+
+// BVNand creates an AST node representing bitwise nand.
+//
+// a and a2 must be bit-vectors of the same size.
+//
+// Maps to: Z3_mk_bvnand
+func (a *AST) BVNand(a2 *AST) *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_bvnand(a.rawCtx, a.rawAST, a2.rawAST),
+	}
+}
+
+// BVNor creates an AST node representing bitwise nor.
+//
+// a and a2 must be bit-vectors of the same size.
+//
+// Maps to: Z3_mk_bvnor
+func (a *AST) BVNor(a2 *AST) *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_bvnor(a.rawCtx, a.rawAST, a2.rawAST),
+	}
+}
+
+// BVXnor creates an AST node representing bitwise xnor.
+//
+// a and a2 must be bit-vectors of the same size.
+//
+// Maps to: Z3_mk_bvxnor
+func (a *AST) BVXnor(a2 *AST) *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_bvxnor(a.rawCtx, a.rawAST, a2.rawAST),
+	}
+}
+
+// BVShl creates an AST node representing a shifted left by a2 bits.
+//
+// a and a2 must be bit-vectors of the same size.
+//
+// Maps to: Z3_mk_bvshl
+func (a *AST) BVShl(a2 *AST) *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_bvshl(a.rawCtx, a.rawAST, a2.rawAST),
+	}
+}
+
+// BVLShr creates an AST node representing a logically shifted right by
+// a2 bits.
+//
+// a and a2 must be bit-vectors of the same size.
+//
+// Maps to: Z3_mk_bvlshr
+func (a *AST) BVLShr(a2 *AST) *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_bvlshr(a.rawCtx, a.rawAST, a2.rawAST),
+	}
+}
+
+// BVAShr creates an AST node representing a arithmetically shifted
+// right by a2 bits.
+//
+// a and a2 must be bit-vectors of the same size.
+//
+// Maps to: Z3_mk_bvashr
+func (a *AST) BVAShr(a2 *AST) *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_bvashr(a.rawCtx, a.rawAST, a2.rawAST),
+	}
+}
+
+// BVRotateLeftBy creates an AST node representing a rotated left by a2
+// bits.
+//
+// a and a2 must be bit-vectors of the same size.
+//
+// Maps to: Z3_mk_ext_rotate_left
+func (a *AST) BVRotateLeftBy(a2 *AST) *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_ext_rotate_left(a.rawCtx, a.rawAST, a2.rawAST),
+	}
+}
+
+// BVRotateRightBy creates an AST node representing a rotated right by
+// a2 bits.
+//
+// a and a2 must be bit-vectors of the same size.
+//
+// Maps to: Z3_mk_ext_rotate_right
+func (a *AST) BVRotateRightBy(a2 *AST) *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_ext_rotate_right(a.rawCtx, a.rawAST, a2.rawAST),
+	}
+}
+
+// BVConcat creates an AST node representing the concatenation of a and
+// a2, with a in the high bits. The result has the combined size of both
+// bit-vectors.
+//
+// Maps to: Z3_mk_concat
+func (a *AST) BVConcat(a2 *AST) *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_concat(a.rawCtx, a.rawAST, a2.rawAST),
+	}
+}
+
+// BVNeg creates an AST node representing two's complement negation.
+//
+// Maps to: Z3_mk_bvneg
+func (a *AST) BVNeg() *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_bvneg(a.rawCtx, a.rawAST),
+	}
+}
+
+// BVNot creates an AST node representing bitwise negation.
+//
+// Maps to: Z3_mk_bvnot
+func (a *AST) BVNot() *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_bvnot(a.rawCtx, a.rawAST),
+	}
+}
+
+// BVRedAnd creates an AST node representing the conjunction of all bits
+// as a bit-vector of size 1.
+//
+// Maps to: Z3_mk_bvredand
+func (a *AST) BVRedAnd() *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_bvredand(a.rawCtx, a.rawAST),
+	}
+}
+
+// BVRedOr creates an AST node representing the disjunction of all bits
+// as a bit-vector of size 1.
+//
+// Maps to: Z3_mk_bvredor
+func (a *AST) BVRedOr() *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_bvredor(a.rawCtx, a.rawAST),
+	}
+}
+
+//-------------------------------------------------------------------
+// Resizing and Rotation
+//-------------------------------------------------------------------
+
+// BVExtract creates an AST node representing the bits high down to low
+// (inclusive) of a. The result is a bit-vector of size high-low+1.
+//
+// Maps to: Z3_mk_extract
+func (a *AST) BVExtract(high, low uint) *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_extract(a.rawCtx, C.uint(high), C.uint(low), a.rawAST),
+	}
+}
+
+// BVRotateLeft creates an AST node representing a rotated left by i bits.
+//
+// Maps to: Z3_mk_rotate_left
+func (a *AST) BVRotateLeft(i uint) *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_rotate_left(a.rawCtx, C.uint(i), a.rawAST),
+	}
+}
+
+// BVRotateRight creates an AST node representing a rotated right by i bits.
+//
+// Maps to: Z3_mk_rotate_right
+func (a *AST) BVRotateRight(i uint) *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_rotate_right(a.rawCtx, C.uint(i), a.rawAST),
+	}
+}
+
+// BVZeroExt creates an AST node representing a extended with i zero
+// bits in the high positions.
+//
+// Maps to: Z3_mk_zero_ext
+func (a *AST) BVZeroExt(i uint) *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_zero_ext(a.rawCtx, C.uint(i), a.rawAST),
+	}
+}
+
+// BVSignExt creates an AST node representing a extended with i copies
+// of its sign bit.
+//
+// Maps to: Z3_mk_sign_ext
+func (a *AST) BVSignExt(i uint) *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_sign_ext(a.rawCtx, C.uint(i), a.rawAST),
+	}
+}
+
+// BVRepeat creates an AST node representing a repeated i times.
+//
+// Maps to: Z3_mk_repeat
+func (a *AST) BVRepeat(i uint) *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_repeat(a.rawCtx, C.uint(i), a.rawAST),
+	}
+}
+
+//-------------------------------------------------------------------
+// Comparisons
+//-------------------------------------------------------------------
+
+// BVULT creates an unsigned "less than" comparison.
+//
+// Maps to: Z3_mk_bvult
+func (a *AST) BVULT(a2 *AST) *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_bvult(a.rawCtx, a.rawAST, a2.rawAST),
+	}
+}
+
+// BVULE creates an unsigned "less than or equal" comparison.
+//
+// Maps to: Z3_mk_bvule
+func (a *AST) BVULE(a2 *AST) *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_bvule(a.rawCtx, a.rawAST, a2.rawAST),
+	}
+}
+
+// BVUGT creates an unsigned "greater than" comparison.
+//
+// Maps to: Z3_mk_bvugt
+func (a *AST) BVUGT(a2 *AST) *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_bvugt(a.rawCtx, a.rawAST, a2.rawAST),
+	}
+}
+
+// BVUGE creates an unsigned "greater than or equal" comparison.
+//
+// Maps to: Z3_mk_bvuge
+func (a *AST) BVUGE(a2 *AST) *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_bvuge(a.rawCtx, a.rawAST, a2.rawAST),
+	}
+}
+
+// BVSLT creates a signed "less than" comparison.
+//
+// Maps to: Z3_mk_bvslt
+func (a *AST) BVSLT(a2 *AST) *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_bvslt(a.rawCtx, a.rawAST, a2.rawAST),
+	}
+}
+
+// BVSLE creates a signed "less than or equal" comparison.
+//
+// Maps to: Z3_mk_bvsle
+func (a *AST) BVSLE(a2 *AST) *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_bvsle(a.rawCtx, a.rawAST, a2.rawAST),
+	}
+}
+
+// BVSGT creates a signed "greater than" comparison.
+//
+// Maps to: Z3_mk_bvsgt
+func (a *AST) BVSGT(a2 *AST) *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_bvsgt(a.rawCtx, a.rawAST, a2.rawAST),
+	}
+}
+
+// BVSGE creates a signed "greater than or equal" comparison.
+//
+// Maps to: Z3_mk_bvsge
+func (a *AST) BVSGE(a2 *AST) *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_bvsge(a.rawCtx, a.rawAST, a2.rawAST),
+	}
+}
+
+//-------------------------------------------------------------------
+// Overflow Predicates
+//-------------------------------------------------------------------
+
+// BVAddNoOverflow creates a predicate that is true if a + a2 does not
+// overflow. signed selects between signed and unsigned addition.
+//
+// Maps to: Z3_mk_bvadd_no_overflow
+func (a *AST) BVAddNoOverflow(a2 *AST, signed bool) *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_bvadd_no_overflow(
+			a.rawCtx, a.rawAST, a2.rawAST, C.bool(signed)),
+	}
+}
+
+// BVAddNoUnderflow creates a predicate that is true if the signed
+// addition a + a2 does not underflow.
+//
+// Maps to: Z3_mk_bvadd_no_underflow
+func (a *AST) BVAddNoUnderflow(a2 *AST) *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_bvadd_no_underflow(a.rawCtx, a.rawAST, a2.rawAST),
+	}
+}
+
+// BVSubNoOverflow creates a predicate that is true if the signed
+// subtraction a - a2 does not overflow.
+//
+// Maps to: Z3_mk_bvsub_no_overflow
+func (a *AST) BVSubNoOverflow(a2 *AST) *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_bvsub_no_overflow(a.rawCtx, a.rawAST, a2.rawAST),
+	}
+}
+
+// BVSubNoUnderflow creates a predicate that is true if a - a2 does not
+// underflow. signed selects between signed and unsigned subtraction.
+//
+// Maps to: Z3_mk_bvsub_no_underflow
+func (a *AST) BVSubNoUnderflow(a2 *AST, signed bool) *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_bvsub_no_underflow(
+			a.rawCtx, a.rawAST, a2.rawAST, C.bool(signed)),
+	}
+}
+
+// BVMulNoOverflow creates a predicate that is true if a * a2 does not
+// overflow. signed selects between signed and unsigned multiplication.
+//
+// Maps to: Z3_mk_bvmul_no_overflow
+func (a *AST) BVMulNoOverflow(a2 *AST, signed bool) *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_bvmul_no_overflow(
+			a.rawCtx, a.rawAST, a2.rawAST, C.bool(signed)),
+	}
+}
+
+// BVMulNoUnderflow creates a predicate that is true if the signed
+// multiplication a * a2 does not underflow.
+//
+// Maps to: Z3_mk_bvmul_no_underflow
+func (a *AST) BVMulNoUnderflow(a2 *AST) *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_bvmul_no_underflow(a.rawCtx, a.rawAST, a2.rawAST),
+	}
+}
+
+// BVSDivNoOverflow creates a predicate that is true if the signed
+// division a / a2 does not overflow.
+//
+// Maps to: Z3_mk_bvsdiv_no_overflow
+func (a *AST) BVSDivNoOverflow(a2 *AST) *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_bvsdiv_no_overflow(a.rawCtx, a.rawAST, a2.rawAST),
+	}
+}
+
+// BVNegNoOverflow creates a predicate that is true if the signed
+// negation of a does not overflow.
+//
+// Maps to: Z3_mk_bvneg_no_overflow
+func (a *AST) BVNegNoOverflow() *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_bvneg_no_overflow(a.rawCtx, a.rawAST),
+	}
+}
+
+//-------------------------------------------------------------------
+// Conversions
+//-------------------------------------------------------------------
+
+// BVToInt creates an AST node converting the bit-vector a into an
+// integer. signed selects whether a is treated as two's complement.
+//
+// Maps to: Z3_mk_bv2int
+func (a *AST) BVToInt(signed bool) *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_bv2int(a.rawCtx, a.rawAST, C.bool(signed)),
+	}
+}
+
+// IntToBV creates an AST node converting the integer a into a
+// bit-vector of the given size.
+//
+// Maps to: Z3_mk_int2bv
+func (a *AST) IntToBV(size uint) *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_int2bv(a.rawCtx, C.uint(size), a.rawAST),
+	}
+}
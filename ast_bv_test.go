@@ -0,0 +1,122 @@
+package z3
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestASTBVAdd(t *testing.T) {
+	config := NewConfig()
+	defer config.Close()
+	ctx := NewContext(config)
+	defer ctx.Close()
+
+	// Create a bit-vector
+	x := ctx.Const(ctx.Symbol("x"), ctx.BitVecSort(8))
+	y := ctx.BitVec(10, 8)
+
+	// Add
+	raw := x.BVAdd(y)
+
+	actual := raw.String()
+	if actual != "(bvadd x #x0a)" {
+		t.Fatalf("bad:\n%s", actual)
+	}
+}
+
+func TestASTBVExtract(t *testing.T) {
+	config := NewConfig()
+	defer config.Close()
+	ctx := NewContext(config)
+	defer ctx.Close()
+
+	// Create a bit-vector
+	x := ctx.Const(ctx.Symbol("x"), ctx.BitVecSort(16))
+
+	// Extract the high byte
+	raw := x.BVExtract(15, 8)
+
+	actual := raw.String()
+	if actual != "((_ extract 15 8) x)" {
+		t.Fatalf("bad:\n%s", actual)
+	}
+}
+
+func TestASTBVULT(t *testing.T) {
+	config := NewConfig()
+	defer config.Close()
+	ctx := NewContext(config)
+	defer ctx.Close()
+
+	// Create a bit-vector
+	x := ctx.Const(ctx.Symbol("x"), ctx.BitVecSort(8))
+	y := ctx.Const(ctx.Symbol("y"), ctx.BitVecSort(8))
+
+	raw := x.BVULT(y)
+
+	actual := raw.String()
+	if actual != "(bvult x y)" {
+		t.Fatalf("bad:\n%s", actual)
+	}
+}
+
+func TestASTBVAddNoOverflow(t *testing.T) {
+	config := NewConfig()
+	defer config.Close()
+	ctx := NewContext(config)
+	defer ctx.Close()
+
+	// Create the solver
+	s := ctx.NewSolver()
+	defer s.Close()
+
+	// 200 + 100 overflows an unsigned byte
+	x := ctx.BitVec(200, 8)
+	y := ctx.BitVec(100, 8)
+	s.Assert(x.BVAddNoOverflow(y, false))
+
+	if v := s.Check(); v != False {
+		t.Fatalf("bad: %v", v)
+	}
+}
+
+func TestASTBVReaders(t *testing.T) {
+	config := NewConfig()
+	defer config.Close()
+	ctx := NewContext(config)
+	defer ctx.Close()
+
+	// Create the solver
+	s := ctx.NewSolver()
+	defer s.Close()
+
+	// x = 0xdeadbeef ++ 0xcafe, y = -1 as 80 bits
+	x := ctx.Const(ctx.Symbol("x"), ctx.BitVecSort(48))
+	y := ctx.Const(ctx.Symbol("y"), ctx.BitVecSort(80))
+	s.Assert(x.Eq(ctx.BitVec(0xdeadbeef, 32).BVConcat(ctx.BitVec(0xcafe, 16))))
+	s.Assert(y.Eq(ctx.BitVecBig(big.NewInt(-1), 80)))
+
+	if v := s.Check(); v != True {
+		t.Fatalf("bad: %v", v)
+	}
+
+	// Get the model
+	m := s.Model()
+	defer m.Close()
+
+	// Read x as a uint64
+	if v, ok := m.Eval(x).Uint64(); !ok || v != 0xdeadbeefcafe {
+		t.Fatalf("bad: %x", v)
+	}
+
+	// Read y as a big.Int
+	expected := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 80), big.NewInt(1))
+	if v := m.Eval(y).BigInt(); v.Cmp(expected) != 0 {
+		t.Fatalf("bad: %s", v)
+	}
+
+	// y doesn't fit into a uint64
+	if _, ok := m.Eval(y).Uint64(); ok {
+		t.Fatal("should not fit")
+	}
+}
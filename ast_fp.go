@@ -0,0 +1,574 @@
+package z3
+
+import (
+	"math"
+	"math/big"
+)
+
+// #include "go-z3.h"
+import "C"
+
+//-------------------------------------------------------------------
+// Rounding Modes
+//-------------------------------------------------------------------
+
+// RoundNearestTiesToEven creates the rounding mode that rounds to the
+// nearest value, with ties going to the value with an even significand.
+// This is the IEEE default.
+//
+// Maps: Z3_mk_fpa_rne
+func (c *Context) RoundNearestTiesToEven() *AST {
+	return &AST{
+		rawCtx: c.raw,
+		rawAST: C.Z3_mk_fpa_rne(c.raw),
+	}
+}
+
+// RoundNearestTiesToAway creates the rounding mode that rounds to the
+// nearest value, with ties going away from zero.
+//
+// Maps: Z3_mk_fpa_rna
+func (c *Context) RoundNearestTiesToAway() *AST {
+	return &AST{
+		rawCtx: c.raw,
+		rawAST: C.Z3_mk_fpa_rna(c.raw),
+	}
+}
+
+// RoundTowardPositive creates the rounding mode that rounds toward
+// positive infinity.
+//
+// Maps: Z3_mk_fpa_rtp
+func (c *Context) RoundTowardPositive() *AST {
+	return &AST{
+		rawCtx: c.raw,
+		rawAST: C.Z3_mk_fpa_rtp(c.raw),
+	}
+}
+
+// RoundTowardNegative creates the rounding mode that rounds toward
+// negative infinity.
+//
+// Maps: Z3_mk_fpa_rtn
+func (c *Context) RoundTowardNegative() *AST {
+	return &AST{
+		rawCtx: c.raw,
+		rawAST: C.Z3_mk_fpa_rtn(c.raw),
+	}
+}
+
+// RoundTowardZero creates the rounding mode that rounds toward zero.
+//
+// Maps: Z3_mk_fpa_rtz
+func (c *Context) RoundTowardZero() *AST {
+	return &AST{
+		rawCtx: c.raw,
+		rawAST: C.Z3_mk_fpa_rtz(c.raw),
+	}
+}
+
+//-------------------------------------------------------------------
+// Floating-point Literals
+//-------------------------------------------------------------------
+
+// Float64 creates a floating-point value of the given type from a
+// float64. If the type isn't FPSort64, the value is converted to it
+// exactly as FPToFP does with RoundNearestTiesToEven: it is rounded to
+// nearest, overflows to an infinity and underflows to a subnormal or
+// zero as IEEE 754 requires.
+//
+// Maps: Z3_mk_fpa_numeral_double
+func (c *Context) Float64(v float64, typ *Sort) *AST {
+	raw := C.Z3_mk_fpa_numeral_double(c.raw, C.double(v), C.Z3_mk_fpa_sort_64(c.raw))
+	return c.fpConvert(raw, typ)
+}
+
+// Float32 creates a floating-point value of the given type from a
+// float32. If the type isn't FPSort32, the value is converted to it the
+// same way as with Float64.
+//
+// Maps: Z3_mk_fpa_numeral_float
+func (c *Context) Float32(v float32, typ *Sort) *AST {
+	raw := C.Z3_mk_fpa_numeral_float(c.raw, C.float(v), C.Z3_mk_fpa_sort_32(c.raw))
+	return c.fpConvert(raw, typ)
+}
+
+// fpConvert converts the floating-point literal raw to typ if it has a
+// different type. Z3 builds literals of a narrower type than the Go
+// value incorrectly, so the literal is built in its own type and
+// rounded with fp.to_fp, which is then simplified back to a literal.
+func (c *Context) fpConvert(raw C.Z3_ast, typ *Sort) *AST {
+	if !C.Z3_is_eq_sort(c.raw, C.Z3_get_sort(c.raw, raw), typ.rawSort) {
+		raw = C.Z3_simplify(c.raw, C.Z3_mk_fpa_to_fp_float(
+			c.raw, C.Z3_mk_fpa_rne(c.raw), raw, typ.rawSort))
+	}
+
+	return &AST{
+		rawCtx: c.raw,
+		rawAST: raw,
+	}
+}
+
+// FPNaN creates the NaN value of the given floating-point type.
+//
+// Maps: Z3_mk_fpa_nan
+func (c *Context) FPNaN(typ *Sort) *AST {
+	return &AST{
+		rawCtx: c.raw,
+		rawAST: C.Z3_mk_fpa_nan(c.raw, typ.rawSort),
+	}
+}
+
+// FPInf creates positive or negative infinity of the given
+// floating-point type.
+//
+// Maps: Z3_mk_fpa_inf
+func (c *Context) FPInf(typ *Sort, negative bool) *AST {
+	return &AST{
+		rawCtx: c.raw,
+		rawAST: C.Z3_mk_fpa_inf(c.raw, typ.rawSort, C.bool(negative)),
+	}
+}
+
+// FPZero creates positive or negative zero of the given floating-point
+// type.
+//
+// Maps: Z3_mk_fpa_zero
+func (c *Context) FPZero(typ *Sort, negative bool) *AST {
+	return &AST{
+		rawCtx: c.raw,
+		rawAST: C.Z3_mk_fpa_zero(c.raw, typ.rawSort, C.bool(negative)),
+	}
+}
+
+// Float64 gets the value of a floating-point numeral as a float64,
+// including NaN, infinities and signed zeros. Values of types with more
+// precision or range than a float64 are rounded to nearest. The second
+// return value is false if the AST isn't a floating-point numeral.
+//
+// Maps: Z3_fpa_get_numeral_significand_string,
+// Z3_fpa_get_numeral_exponent_int64
+func (a *AST) Float64() (float64, bool) {
+	sort := C.Z3_get_sort(a.rawCtx, a.rawAST)
+	if C.Z3_get_sort_kind(a.rawCtx, sort) != C.Z3_FLOATING_POINT_SORT ||
+		!bool(C.Z3_is_numeral_ast(a.rawCtx, a.rawAST)) {
+		return 0, false
+	}
+
+	if bool(C.Z3_fpa_is_numeral_nan(a.rawCtx, a.rawAST)) {
+		return math.NaN(), true
+	}
+
+	sign := 1
+	if bool(C.Z3_fpa_is_numeral_negative(a.rawCtx, a.rawAST)) {
+		sign = -1
+	}
+
+	switch {
+	case bool(C.Z3_fpa_is_numeral_inf(a.rawCtx, a.rawAST)):
+		return math.Inf(sign), true
+	case bool(C.Z3_fpa_is_numeral_zero(a.rawCtx, a.rawAST)):
+		return math.Copysign(0, float64(sign)), true
+	}
+
+	// The value is significand * 2^exponent, where Z3 gives the
+	// significand as an exact decimal in [0, 2).
+	var exp C.int64_t
+	if !bool(C.Z3_fpa_get_numeral_exponent_int64(a.rawCtx, a.rawAST, &exp, false)) {
+		return 0, false
+	}
+	sig, ok := new(big.Float).SetPrec(0).SetMode(big.ToNearestEven).SetString(
+		C.GoString(C.Z3_fpa_get_numeral_significand_string(a.rawCtx, a.rawAST)))
+	if !ok {
+		return 0, false
+	}
+
+	v, _ := sig.SetMantExp(sig, int(exp)).Float64()
+	return float64(sign) * v, true
+}
+
+//-------------------------------------------------------------------
+// Floating-point Arithmetic
+//-------------------------------------------------------------------
+
+// FPAdd creates an AST node representing a + a2, rounded
+// with the rounding mode rm.
+//
+// Maps to: Z3_mk_fpa_add
+func (a *AST) FPAdd(rm, a2 *AST) *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_fpa_add(a.rawCtx, rm.rawAST, a.rawAST, a2.rawAST),
+	}
+}
+
+// FPSub creates an AST node representing a - a2, rounded
+// with the rounding mode rm.
+//
+// Maps to: Z3_mk_fpa_sub
+func (a *AST) FPSub(rm, a2 *AST) *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_fpa_sub(a.rawCtx, rm.rawAST, a.rawAST, a2.rawAST),
+	}
+}
+
+// FPMul creates an AST node representing a * a2, rounded
+// with the rounding mode rm.
+//
+// Maps to: Z3_mk_fpa_mul
+func (a *AST) FPMul(rm, a2 *AST) *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_fpa_mul(a.rawCtx, rm.rawAST, a.rawAST, a2.rawAST),
+	}
+}
+
+// FPDiv creates an AST node representing a / a2, rounded
+// with the rounding mode rm.
+//
+// Maps to: Z3_mk_fpa_div
+func (a *AST) FPDiv(rm, a2 *AST) *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_fpa_div(a.rawCtx, rm.rawAST, a.rawAST, a2.rawAST),
+	}
+}
+
+// FPFMA creates an AST node representing the fused multiply-add
+// a * a2 + a3, rounded once with the rounding mode rm.
+//
+// Maps to: Z3_mk_fpa_fma
+func (a *AST) FPFMA(rm, a2, a3 *AST) *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_fpa_fma(a.rawCtx, rm.rawAST, a.rawAST, a2.rawAST, a3.rawAST),
+	}
+}
+
+// FPSqrt creates an AST node representing the square root of a, rounded
+// with the rounding mode rm.
+//
+// Maps to: Z3_mk_fpa_sqrt
+func (a *AST) FPSqrt(rm *AST) *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_fpa_sqrt(a.rawCtx, rm.rawAST, a.rawAST),
+	}
+}
+
+// FPRoundToIntegral creates an AST node representing a rounded to an
+// integral floating-point value with the rounding mode rm.
+//
+// Maps to: Z3_mk_fpa_round_to_integral
+func (a *AST) FPRoundToIntegral(rm *AST) *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_fpa_round_to_integral(a.rawCtx, rm.rawAST, a.rawAST),
+	}
+}
+
+// FPRem creates an AST node representing the IEEE remainder of a / a2.
+//
+// Maps to: Z3_mk_fpa_rem
+func (a *AST) FPRem(a2 *AST) *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_fpa_rem(a.rawCtx, a.rawAST, a2.rawAST),
+	}
+}
+
+// FPMin creates an AST node representing the minimum of a and a2.
+//
+// Maps to: Z3_mk_fpa_min
+func (a *AST) FPMin(a2 *AST) *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_fpa_min(a.rawCtx, a.rawAST, a2.rawAST),
+	}
+}
+
+// FPMax creates an AST node representing the maximum of a and a2.
+//
+// Maps to: Z3_mk_fpa_max
+func (a *AST) FPMax(a2 *AST) *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_fpa_max(a.rawCtx, a.rawAST, a2.rawAST),
+	}
+}
+
+// FPAbs creates an AST node representing the absolute value of a.
+//
+// Maps to: Z3_mk_fpa_abs
+func (a *AST) FPAbs() *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_fpa_abs(a.rawCtx, a.rawAST),
+	}
+}
+
+// FPNeg creates an AST node representing the negation of a.
+//
+// Maps to: Z3_mk_fpa_neg
+func (a *AST) FPNeg() *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_fpa_neg(a.rawCtx, a.rawAST),
+	}
+}
+
+//-------------------------------------------------------------------
+// Floating-point Comparisons and Classification
+//-------------------------------------------------------------------
+
+// FPEq creates an IEEE equality comparison. Unlike Eq, NaN is not equal
+// to itself and positive and negative zero are equal.
+//
+// Maps to: Z3_mk_fpa_eq
+func (a *AST) FPEq(a2 *AST) *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_fpa_eq(a.rawCtx, a.rawAST, a2.rawAST),
+	}
+}
+
+// FPLt creates a "less than" comparison.
+//
+// Maps to: Z3_mk_fpa_lt
+func (a *AST) FPLt(a2 *AST) *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_fpa_lt(a.rawCtx, a.rawAST, a2.rawAST),
+	}
+}
+
+// FPLe creates a "less than or equal" comparison.
+//
+// Maps to: Z3_mk_fpa_leq
+func (a *AST) FPLe(a2 *AST) *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_fpa_leq(a.rawCtx, a.rawAST, a2.rawAST),
+	}
+}
+
+// FPGt creates a "greater than" comparison.
+//
+// Maps to: Z3_mk_fpa_gt
+func (a *AST) FPGt(a2 *AST) *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_fpa_gt(a.rawCtx, a.rawAST, a2.rawAST),
+	}
+}
+
+// FPGe creates a "greater than or equal" comparison.
+//
+// Maps to: Z3_mk_fpa_geq
+func (a *AST) FPGe(a2 *AST) *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_fpa_geq(a.rawCtx, a.rawAST, a2.rawAST),
+	}
+}
+
+// FPIsNormal creates a predicate that is true if a is a normal number.
+//
+// Maps to: Z3_mk_fpa_is_normal
+func (a *AST) FPIsNormal() *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_fpa_is_normal(a.rawCtx, a.rawAST),
+	}
+}
+
+// FPIsSubnormal creates a predicate that is true if a is a subnormal
+// number.
+//
+// Maps to: Z3_mk_fpa_is_subnormal
+func (a *AST) FPIsSubnormal() *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_fpa_is_subnormal(a.rawCtx, a.rawAST),
+	}
+}
+
+// FPIsZero creates a predicate that is true if a is positive or
+// negative zero.
+//
+// Maps to: Z3_mk_fpa_is_zero
+func (a *AST) FPIsZero() *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_fpa_is_zero(a.rawCtx, a.rawAST),
+	}
+}
+
+// FPIsInfinite creates a predicate that is true if a is positive or
+// negative infinity.
+//
+// Maps to: Z3_mk_fpa_is_infinite
+func (a *AST) FPIsInfinite() *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_fpa_is_infinite(a.rawCtx, a.rawAST),
+	}
+}
+
+// FPIsNaN creates a predicate that is true if a is NaN.
+//
+// Maps to: Z3_mk_fpa_is_nan
+func (a *AST) FPIsNaN() *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_fpa_is_nan(a.rawCtx, a.rawAST),
+	}
+}
+
+// FPIsNegative creates a predicate that is true if a is negative and
+// not NaN.
+//
+// Maps to: Z3_mk_fpa_is_negative
+func (a *AST) FPIsNegative() *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_fpa_is_negative(a.rawCtx, a.rawAST),
+	}
+}
+
+// FPIsPositive creates a predicate that is true if a is positive and
+// not NaN.
+//
+// Maps to: Z3_mk_fpa_is_positive
+func (a *AST) FPIsPositive() *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_fpa_is_positive(a.rawCtx, a.rawAST),
+	}
+}
+
+//-------------------------------------------------------------------
+// Floating-point Conversions
+//-------------------------------------------------------------------
+
+// FPToFP creates an AST node converting the floating-point value a to
+// the floating-point type typ, rounding with the rounding mode rm.
+//
+// Maps to: Z3_mk_fpa_to_fp_float
+func (a *AST) FPToFP(rm *AST, typ *Sort) *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_fpa_to_fp_float(a.rawCtx, rm.rawAST, a.rawAST, typ.rawSort),
+	}
+}
+
+// FPToReal creates an AST node converting the floating-point value a to
+// a real. The result is unspecified for NaN and infinities.
+//
+// Maps to: Z3_mk_fpa_to_real
+func (a *AST) FPToReal() *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_fpa_to_real(a.rawCtx, a.rawAST),
+	}
+}
+
+// FPToInt creates an AST node converting the floating-point value a to
+// an integer, rounding with the rounding mode rm. The result is
+// unspecified for NaN and infinities.
+//
+// This doesn't map to any specific Z3 API. It combines
+// FPRoundToIntegral, FPToReal and ToInt.
+func (a *AST) FPToInt(rm *AST) *AST {
+	return a.FPRoundToIntegral(rm).FPToReal().ToInt()
+}
+
+// FPToUBV creates an AST node converting the floating-point value a to
+// an unsigned bit-vector of the given size, rounding with the rounding
+// mode rm.
+//
+// Maps to: Z3_mk_fpa_to_ubv
+func (a *AST) FPToUBV(rm *AST, size uint) *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_fpa_to_ubv(a.rawCtx, rm.rawAST, a.rawAST, C.uint(size)),
+	}
+}
+
+// FPToSBV creates an AST node converting the floating-point value a to
+// a signed bit-vector of the given size, rounding with the rounding mode
+// rm.
+//
+// Maps to: Z3_mk_fpa_to_sbv
+func (a *AST) FPToSBV(rm *AST, size uint) *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_fpa_to_sbv(a.rawCtx, rm.rawAST, a.rawAST, C.uint(size)),
+	}
+}
+
+// FPToIEEEBV creates an AST node representing the IEEE 754 bit pattern
+// of the floating-point value a. NaN may map to any NaN bit pattern.
+//
+// Maps to: Z3_mk_fpa_to_ieee_bv
+func (a *AST) FPToIEEEBV() *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_fpa_to_ieee_bv(a.rawCtx, a.rawAST),
+	}
+}
+
+// BVToFP creates an AST node interpreting the bit-vector a as the IEEE
+// 754 bit pattern of a value of the floating-point type typ. The size of
+// a must be the total number of bits of typ.
+//
+// Maps to: Z3_mk_fpa_to_fp_bv
+func (a *AST) BVToFP(typ *Sort) *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_fpa_to_fp_bv(a.rawCtx, a.rawAST, typ.rawSort),
+	}
+}
+
+// SBVToFP creates an AST node converting the signed bit-vector a to the
+// floating-point type typ, rounding with the rounding mode rm.
+//
+// Maps to: Z3_mk_fpa_to_fp_signed
+func (a *AST) SBVToFP(rm *AST, typ *Sort) *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_fpa_to_fp_signed(a.rawCtx, rm.rawAST, a.rawAST, typ.rawSort),
+	}
+}
+
+// UBVToFP creates an AST node converting the unsigned bit-vector a to
+// the floating-point type typ, rounding with the rounding mode rm.
+//
+// Maps to: Z3_mk_fpa_to_fp_unsigned
+func (a *AST) UBVToFP(rm *AST, typ *Sort) *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_fpa_to_fp_unsigned(a.rawCtx, rm.rawAST, a.rawAST, typ.rawSort),
+	}
+}
+
+// RealToFP creates an AST node converting the real a to the
+// floating-point type typ, rounding with the rounding mode rm.
+//
+// Maps to: Z3_mk_fpa_to_fp_real
+func (a *AST) RealToFP(rm *AST, typ *Sort) *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_fpa_to_fp_real(a.rawCtx, rm.rawAST, a.rawAST, typ.rawSort),
+	}
+}
+
+// IntToFP creates an AST node converting the integer a to the
+// floating-point type typ, rounding with the rounding mode rm.
+//
+// This doesn't map to any specific Z3 API. It combines ToReal and
+// RealToFP.
+func (a *AST) IntToFP(rm *AST, typ *Sort) *AST {
+	return a.ToReal().RealToFP(rm, typ)
+}
@@ -0,0 +1,189 @@
+package z3
+
+import (
+	"math"
+	"testing"
+)
+
+func TestASTFPAdd(t *testing.T) {
+	config := NewConfig()
+	defer config.Close()
+	ctx := NewContext(config)
+	defer ctx.Close()
+
+	// Create the solver
+	s := ctx.NewSolver()
+	defer s.Close()
+
+	// x = 0.1 + 0.2 in double precision
+	sort := ctx.FPSort64()
+	x := ctx.Const(ctx.Symbol("x"), sort)
+	rm := ctx.RoundNearestTiesToEven()
+	s.Assert(x.Eq(ctx.Float64(0.1, sort).FPAdd(rm, ctx.Float64(0.2, sort))))
+
+	if v := s.Check(); v != True {
+		t.Fatalf("bad: %v", v)
+	}
+
+	// Get the model
+	m := s.Model()
+	defer m.Close()
+
+	// The sum is rounded exactly like Go rounds it
+	a, b := 0.1, 0.2
+	if v, ok := m.Eval(x).Float64(); !ok || v != a+b {
+		t.Fatalf("bad: %v", v)
+	}
+}
+
+func TestASTFPSort(t *testing.T) {
+	config := NewConfig()
+	defer config.Close()
+	ctx := NewContext(config)
+	defer ctx.Close()
+
+	sort := ctx.FPSort32()
+	if e, s := sort.FPEBits(), sort.FPSBits(); e != 8 || s != 24 {
+		t.Fatalf("bad: %d %d", e, s)
+	}
+
+	if v := ctx.FPSort(8, 24).String(); v != sort.String() {
+		t.Fatalf("bad: %s", v)
+	}
+}
+
+func TestASTFPIsNaN(t *testing.T) {
+	config := NewConfig()
+	defer config.Close()
+	ctx := NewContext(config)
+	defer ctx.Close()
+
+	// Create the solver
+	s := ctx.NewSolver()
+	defer s.Close()
+
+	// sqrt(x) is NaN and x is not NaN, so x must be negative
+	sort := ctx.FPSort32()
+	x := ctx.Const(ctx.Symbol("x"), sort)
+	s.Assert(x.FPSqrt(ctx.RoundTowardZero()).FPIsNaN())
+	s.Assert(x.FPIsNaN().Not())
+	s.Assert(x.FPIsInfinite().Not())
+
+	if v := s.Check(); v != True {
+		t.Fatalf("bad: %v", v)
+	}
+
+	// Get the model
+	m := s.Model()
+	defer m.Close()
+
+	if v, ok := m.Eval(x).Float64(); !ok || v >= 0 || math.IsInf(v, 0) {
+		t.Fatalf("bad: %v", v)
+	}
+}
+
+func TestASTFPConversions(t *testing.T) {
+	config := NewConfig()
+	defer config.Close()
+	ctx := NewContext(config)
+	defer ctx.Close()
+
+	// Create the solver
+	s := ctx.NewSolver()
+	defer s.Close()
+
+	// Round-trip 2.5 through the integers, the reals and its bit pattern
+	sort := ctx.FPSort64()
+	rm := ctx.RoundNearestTiesToEven()
+	x := ctx.Float64(2.5, sort)
+	i := ctx.Const(ctx.Symbol("i"), ctx.IntSort())
+	r := ctx.Const(ctx.Symbol("r"), ctx.RealSort())
+	bv := ctx.Const(ctx.Symbol("bv"), ctx.BitVecSort(64))
+	y := ctx.Const(ctx.Symbol("y"), sort)
+	z := ctx.Const(ctx.Symbol("z"), sort)
+	s.Assert(i.Eq(x.FPToInt(rm)))
+	s.Assert(r.Eq(x.FPToReal()))
+	s.Assert(bv.Eq(x.FPToIEEEBV()))
+	s.Assert(y.Eq(i.IntToFP(rm, sort)))
+	s.Assert(z.Eq(bv.BVToFP(sort)))
+
+	if v := s.Check(); v != True {
+		t.Fatalf("bad: %v", v)
+	}
+
+	// Get the model
+	m := s.Model()
+	defer m.Close()
+
+	// Ties go to even
+	if v := m.Eval(i).Int(); v != 2 {
+		t.Fatalf("bad: %v", v)
+	}
+	if v := m.Eval(r).Rat(); v == nil || v.RatString() != "5/2" {
+		t.Fatalf("bad: %v", v)
+	}
+	if v, ok := m.Eval(bv).Uint64(); !ok || v != math.Float64bits(2.5) {
+		t.Fatalf("bad: %x", v)
+	}
+	if v, ok := m.Eval(z).Float64(); !ok || v != 2.5 {
+		t.Fatalf("bad: %v", v)
+	}
+	if v, ok := m.Eval(y).Float64(); !ok || v != 2 {
+		t.Fatalf("bad: %v", v)
+	}
+}
+
+func TestASTFloat64(t *testing.T) {
+	config := NewConfig()
+	defer config.Close()
+	ctx := NewContext(config)
+	defer ctx.Close()
+
+	cases := []struct {
+		Name string
+		AST  *AST
+		Want float64
+	}{
+		{"normal", ctx.Float64(-1.5e300, ctx.FPSort64()), -1.5e300},
+		{"subnormal", ctx.Float64(5e-324, ctx.FPSort64()), 5e-324},
+		{"float32", ctx.Float32(0.1, ctx.FPSort32()), float64(float32(0.1))},
+		{"half", ctx.Float64(-0.375, ctx.FPSort16()), -0.375},
+		{"quad", ctx.Float64(0.1, ctx.FPSort128()), 0.1},
+		{"widen", ctx.Float32(0.1, ctx.FPSort64()), float64(float32(0.1))},
+		{"narrow", ctx.Float64(0.1, ctx.FPSort32()), float64(float32(0.1))},
+		{"narrow up", ctx.Float64(1+0x1p-24+0x1p-30, ctx.FPSort32()), 1 + 0x1p-23},
+		{"narrow even", ctx.Float64(1+0x1p-24, ctx.FPSort32()), 1},
+		{"narrow half", ctx.Float32(1+0x1p-11, ctx.FPSort16()), 1},
+		{"overflow", ctx.Float64(math.MaxFloat64, ctx.FPSort32()), math.Inf(1)},
+		{"-overflow", ctx.Float64(-1e39, ctx.FPSort32()), math.Inf(-1)},
+		{"overflow half", ctx.Float64(70000, ctx.FPSort16()), math.Inf(1)},
+		{"subnormal narrow", ctx.Float64(1e-40, ctx.FPSort32()), float64(float32(1e-40))},
+		{"subnormal half", ctx.Float32(0x1p-24, ctx.FPSort16()), 0x1p-24},
+		{"underflow", ctx.Float64(1e-310, ctx.FPSort32()), 0},
+		{"underflow half", ctx.Float64(0x1p-26, ctx.FPSort16()), 0},
+		{"inf", ctx.FPInf(ctx.FPSort32(), false), math.Inf(1)},
+		{"-inf", ctx.FPInf(ctx.FPSort32(), true), math.Inf(-1)},
+		{"zero", ctx.FPZero(ctx.FPSort64(), false), 0},
+		{"nan", ctx.FPNaN(ctx.FPSort64()), math.NaN()},
+	}
+
+	for _, tc := range cases {
+		v, ok := tc.AST.Float64()
+		if !ok {
+			t.Fatalf("%s: not a numeral", tc.Name)
+		}
+		if math.Float64bits(v) != math.Float64bits(tc.Want) && !(math.IsNaN(v) && math.IsNaN(tc.Want)) {
+			t.Fatalf("%s: bad: %v", tc.Name, v)
+		}
+	}
+
+	// Negative zero keeps its sign
+	if v, ok := ctx.FPZero(ctx.FPSort64(), true).Float64(); !ok || v != 0 || !math.Signbit(v) {
+		t.Fatalf("bad: %v", v)
+	}
+
+	// Not a floating-point numeral
+	if _, ok := ctx.Int(1, ctx.IntSort()).Float64(); ok {
+		t.Fatal("should not be a float")
+	}
+}
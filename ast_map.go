@@ -0,0 +1,160 @@
+package z3
+
+// #include "go-z3.h"
+import "C"
+
+// astKey identifies an AST node by Z3 identity. IDs are only unique
+// within a Context, so the context is part of the key.
+type astKey struct {
+	rawCtx C.Z3_context
+	id     uint
+}
+
+func keyOf(a *AST) astKey {
+	return astKey{rawCtx: a.rawCtx, id: a.ID()}
+}
+
+//-------------------------------------------------------------------
+// ASTMap
+//-------------------------------------------------------------------
+
+// ASTMap is a map from ASTs to arbitrary values. Different *AST values
+// for the same Z3 node, as compared by AST.Equal, refer to the same
+// entry, so the map can be used to memoize results per term.
+//
+// This is the Go analogue of Z3_ast_map. Keys are returned in the order
+// they were first set. The zero value is not usable; create maps with
+// NewASTMap.
+type ASTMap struct {
+	index map[astKey]int
+	keys  []*AST
+	vals  []interface{}
+}
+
+// NewASTMap creates a new, empty map.
+func NewASTMap() *ASTMap {
+	return &ASTMap{index: make(map[astKey]int)}
+}
+
+// Len returns the number of entries.
+func (m *ASTMap) Len() int {
+	return len(m.keys)
+}
+
+// Get returns the value for the key k and whether it was found.
+func (m *ASTMap) Get(k *AST) (interface{}, bool) {
+	i, ok := m.index[keyOf(k)]
+	if !ok {
+		return nil, false
+	}
+
+	return m.vals[i], true
+}
+
+// Contains returns true if the map has an entry for the key k.
+func (m *ASTMap) Contains(k *AST) bool {
+	_, ok := m.index[keyOf(k)]
+	return ok
+}
+
+// Set sets the value for the key k, replacing any existing value.
+func (m *ASTMap) Set(k *AST, v interface{}) {
+	key := keyOf(k)
+	if i, ok := m.index[key]; ok {
+		m.vals[i] = v
+		return
+	}
+
+	m.index[key] = len(m.keys)
+	m.keys = append(m.keys, k)
+	m.vals = append(m.vals, v)
+}
+
+// Delete removes the entry for the key k, if any. This is linear in the
+// number of entries since the order of the remaining keys is kept.
+func (m *ASTMap) Delete(k *AST) {
+	key := keyOf(k)
+	i, ok := m.index[key]
+	if !ok {
+		return
+	}
+
+	delete(m.index, key)
+	m.keys = append(m.keys[:i], m.keys[i+1:]...)
+	m.vals = append(m.vals[:i], m.vals[i+1:]...)
+	for j := i; j < len(m.keys); j++ {
+		m.index[keyOf(m.keys[j])] = j
+	}
+}
+
+// Keys returns the keys of the map in the order they were first set.
+func (m *ASTMap) Keys() []*AST {
+	result := make([]*AST, len(m.keys))
+	copy(result, m.keys)
+	return result
+}
+
+// Range calls f for every entry in the order the keys were first set,
+// stopping early if f returns false. The map must not be modified by f.
+func (m *ASTMap) Range(f func(k *AST, v interface{}) bool) {
+	for i, k := range m.keys {
+		if !f(k, m.vals[i]) {
+			return
+		}
+	}
+}
+
+//-------------------------------------------------------------------
+// ASTSet
+//-------------------------------------------------------------------
+
+// ASTSet is a set of ASTs. Different *AST values for the same Z3 node,
+// as compared by AST.Equal, are the same member, so the set can be used
+// to deduplicate constraints.
+//
+// Members are returned in the order they were first added. The zero
+// value is not usable; create sets with NewASTSet.
+type ASTSet struct {
+	m *ASTMap
+}
+
+// NewASTSet creates a new set containing the given ASTs.
+func NewASTSet(items ...*AST) *ASTSet {
+	s := &ASTSet{m: NewASTMap()}
+	for _, a := range items {
+		s.Add(a)
+	}
+
+	return s
+}
+
+// Len returns the number of members.
+func (s *ASTSet) Len() int {
+	return s.m.Len()
+}
+
+// Add adds a to the set. It returns true if a wasn't already a member.
+func (s *ASTSet) Add(a *AST) bool {
+	if s.m.Contains(a) {
+		return false
+	}
+
+	s.m.Set(a, nil)
+	return true
+}
+
+// Contains returns true if a is a member of the set.
+func (s *ASTSet) Contains(a *AST) bool {
+	return s.m.Contains(a)
+}
+
+// Remove removes a from the set, if it is a member.
+func (s *ASTSet) Remove(a *AST) {
+	s.m.Delete(a)
+}
+
+// Items returns the members of the set in the order they were first
+// added.
+func (s *ASTSet) Items() []*AST {
+	return s.m.Keys()
+}
@@ -0,0 +1,102 @@
+package z3
+
+import (
+	"testing"
+)
+
+func TestASTMap(t *testing.T) {
+	config := NewConfig()
+	defer config.Close()
+	ctx := NewContext(config)
+	defer ctx.Close()
+
+	x := ctx.Const(ctx.Symbol("x"), ctx.IntSort())
+	y := ctx.Const(ctx.Symbol("y"), ctx.IntSort())
+
+	m := NewASTMap()
+	m.Set(x.Add(y), 1)
+	m.Set(x, 2)
+
+	// A different wrapper for the same node finds the entry
+	if v, ok := m.Get(x.Add(y)); !ok || v != 1 {
+		t.Fatalf("bad: %v", v)
+	}
+	if _, ok := m.Get(y); ok {
+		t.Fatal("should not be found")
+	}
+
+	// Setting again replaces the value but keeps the order
+	m.Set(ctx.Const(ctx.Symbol("x"), ctx.IntSort()), 3)
+	if v := m.Len(); v != 2 {
+		t.Fatalf("bad: %d", v)
+	}
+	if v, _ := m.Get(x); v != 3 {
+		t.Fatalf("bad: %v", v)
+	}
+
+	var actual []string
+	m.Range(func(k *AST, v interface{}) bool {
+		actual = append(actual, k.String())
+		return true
+	})
+	if len(actual) != 2 || actual[0] != "(+ x y)" || actual[1] != "x" {
+		t.Fatalf("bad: %v", actual)
+	}
+
+	// Delete
+	m.Delete(x.Add(y))
+	if v := m.Keys(); len(v) != 1 || !v[0].Equal(x) {
+		t.Fatalf("bad: %v", v)
+	}
+	if v, _ := m.Get(x); v != 3 {
+		t.Fatalf("bad: %v", v)
+	}
+}
+
+func TestASTSet(t *testing.T) {
+	config := NewConfig()
+	defer config.Close()
+	ctx := NewContext(config)
+	defer ctx.Close()
+
+	// Deduplicate constraints
+	x := ctx.Const(ctx.Symbol("x"), ctx.IntSort())
+	zero := ctx.Int(0, ctx.IntSort())
+	s := NewASTSet(x.Gt(zero), x.Lt(ctx.Int(10, ctx.IntSort())))
+	if s.Add(x.Gt(zero)) {
+		t.Fatal("should already be a member")
+	}
+	if !s.Add(x.Eq(zero).Not()) {
+		t.Fatal("should be added")
+	}
+
+	if v := s.Len(); v != 3 {
+		t.Fatalf("bad: %d", v)
+	}
+	if !s.Contains(x.Gt(zero)) || s.Contains(x.Ge(zero)) {
+		t.Fatal("bad membership")
+	}
+
+	s.Remove(x.Gt(zero))
+	if v := s.Items(); len(v) != 2 || v[0].String() != "(< x 10)" {
+		t.Fatalf("bad: %v", v)
+	}
+}
+
+func TestASTMapContexts(t *testing.T) {
+	config := NewConfig()
+	defer config.Close()
+	ctx1 := NewContext(config)
+	defer ctx1.Close()
+	ctx2 := NewContext(config)
+	defer ctx2.Close()
+
+	// Nodes from different contexts may share IDs but are distinct keys
+	x1 := ctx1.Const(ctx1.Symbol("x"), ctx1.IntSort())
+	x2 := ctx2.Const(ctx2.Symbol("x"), ctx2.IntSort())
+
+	s := NewASTSet(x1, x2)
+	if v := s.Len(); v != 2 {
+		t.Fatalf("bad: %d", v)
+	}
+}
@@ -0,0 +1,297 @@
+package z3
+
+import (
+	"unsafe"
+)
+
+// #include "go-z3.h"
+import "C"
+
+// Pattern is a trigger pattern for a quantifier. Z3 instantiates a
+// quantifier when it finds ground terms matching one of its patterns.
+// Patterns are created with Context.Pattern.
+type Pattern struct {
+	rawCtx     C.Z3_context
+	rawPattern C.Z3_pattern
+}
+
+// QuantifierOptions are the optional settings for a quantifier created
+// with Context.ForAllEx or Context.ExistsEx.
+type QuantifierOptions struct {
+	// Weight is a hint for the instantiation heuristics. Quantifiers
+	// with a lower weight are instantiated more eagerly. Zero uses the
+	// default weight of 1.
+	Weight uint
+
+	// Patterns are the trigger patterns for the quantifier. If none are
+	// given, Z3 infers them.
+	Patterns []*Pattern
+
+	// NoPatterns are terms that must not be used as patterns when Z3
+	// infers them.
+	NoPatterns []*AST
+}
+
+// QuantifierKind is the kind of a quantifier AST.
+type QuantifierKind uint8
+
+const (
+	QuantifierForAll QuantifierKind = iota
+	QuantifierExists
+	QuantifierLambda
+)
+
+// Pattern creates a multi-pattern from the given terms. Every term must
+// be a function application that mentions the bound variables. At least
+// one term must be given; otherwise an ErrorCodeInvalidPattern error is
+// recorded on the Context.
+//
+// Maps: Z3_mk_pattern
+func (c *Context) Pattern(terms ...*AST) *Pattern {
+	if len(terms) == 0 {
+		C.Z3_set_error(c.raw, C.Z3_INVALID_PATTERN)
+	}
+
+	raws := make([]C.Z3_ast, len(terms))
+	for i, t := range terms {
+		raws[i] = t.rawAST
+	}
+
+	var rawTerms *C.Z3_ast
+	if len(raws) > 0 {
+		rawTerms = (*C.Z3_ast)(unsafe.Pointer(&raws[0]))
+	}
+
+	return &Pattern{
+		rawCtx:     c.raw,
+		rawPattern: C.Z3_mk_pattern(c.raw, C.uint(len(raws)), rawTerms),
+	}
+}
+
+// String returns a human-friendly string version of the pattern.
+func (p *Pattern) String() string {
+	return C.GoString(C.Z3_pattern_to_string(p.rawCtx, p.rawPattern))
+}
+
+// ForAll creates a universal quantifier over the given constants, which
+// become bound within body. At least one constant must be bound;
+// otherwise an error is recorded on the Context.
+//
+// Maps: Z3_mk_quantifier_const_ex
+func (c *Context) ForAll(bound []*AST, body *AST) *AST {
+	return c.quantifier(true, bound, body, nil)
+}
+
+// ForAllEx is like ForAll but with additional options such as patterns.
+// opts may be nil.
+//
+// Maps: Z3_mk_quantifier_const_ex
+func (c *Context) ForAllEx(bound []*AST, body *AST, opts *QuantifierOptions) *AST {
+	return c.quantifier(true, bound, body, opts)
+}
+
+// Exists creates an existential quantifier over the given constants,
+// which become bound within body. Like ForAll, at least one constant
+// must be bound.
+//
+// Maps: Z3_mk_quantifier_const_ex
+func (c *Context) Exists(bound []*AST, body *AST) *AST {
+	return c.quantifier(false, bound, body, nil)
+}
+
+// ExistsEx is like Exists but with additional options such as patterns.
+// opts may be nil.
+//
+// Maps: Z3_mk_quantifier_const_ex
+func (c *Context) ExistsEx(bound []*AST, body *AST, opts *QuantifierOptions) *AST {
+	return c.quantifier(false, bound, body, opts)
+}
+
+// Lambda creates a lambda expression over the given constants, which
+// become bound within body. The result is an array from the types of the
+// bound constants to the type of body. Like ForAll, at least one
+// constant must be bound.
+//
+// Maps: Z3_mk_lambda_const
+func (c *Context) Lambda(bound []*AST, body *AST) *AST {
+	rawBound := toRawApps(c.raw, bound)
+	var rawBoundPtr *C.Z3_app
+	if len(rawBound) > 0 {
+		rawBoundPtr = (*C.Z3_app)(unsafe.Pointer(&rawBound[0]))
+	}
+
+	return &AST{
+		rawCtx: c.raw,
+		rawAST: C.Z3_mk_lambda_const(
+			c.raw,
+			C.uint(len(rawBound)),
+			rawBoundPtr,
+			body.rawAST),
+	}
+}
+
+// BoundVar creates a bound variable referenced by its de-Bruijn index.
+// Index 0 refers to the innermost bound variable. This is only needed
+// for working with quantifier bodies directly; ForAll and Exists bind
+// constants automatically.
+//
+// Maps: Z3_mk_bound
+func (c *Context) BoundVar(idx uint, typ *Sort) *AST {
+	return &AST{
+		rawCtx: c.raw,
+		rawAST: C.Z3_mk_bound(c.raw, C.uint(idx), typ.rawSort),
+	}
+}
+
+func (c *Context) quantifier(
+	forall bool, bound []*AST, body *AST, opts *QuantifierOptions) *AST {
+	if opts == nil {
+		opts = &QuantifierOptions{}
+	}
+
+	weight := opts.Weight
+	if weight == 0 {
+		weight = 1
+	}
+
+	rawBound := toRawApps(c.raw, bound)
+	var rawBoundPtr *C.Z3_app
+	if len(rawBound) > 0 {
+		rawBoundPtr = (*C.Z3_app)(unsafe.Pointer(&rawBound[0]))
+	}
+
+	rawPatterns := make([]C.Z3_pattern, len(opts.Patterns))
+	for i, p := range opts.Patterns {
+		rawPatterns[i] = p.rawPattern
+	}
+	var rawPatternsPtr *C.Z3_pattern
+	if len(rawPatterns) > 0 {
+		rawPatternsPtr = (*C.Z3_pattern)(unsafe.Pointer(&rawPatterns[0]))
+	}
+
+	rawNoPatterns := make([]C.Z3_ast, len(opts.NoPatterns))
+	for i, p := range opts.NoPatterns {
+		rawNoPatterns[i] = p.rawAST
+	}
+	var rawNoPatternsPtr *C.Z3_ast
+	if len(rawNoPatterns) > 0 {
+		rawNoPatternsPtr = (*C.Z3_ast)(unsafe.Pointer(&rawNoPatterns[0]))
+	}
+
+	return &AST{
+		rawCtx: c.raw,
+		rawAST: C.Z3_mk_quantifier_const_ex(
+			c.raw,
+			C.bool(forall),
+			C.uint(weight),
+			nil,
+			nil,
+			C.uint(len(rawBound)),
+			rawBoundPtr,
+			C.uint(len(rawPatterns)),
+			rawPatternsPtr,
+			C.uint(len(rawNoPatterns)),
+			rawNoPatternsPtr,
+			body.rawAST),
+	}
+}
+
+// toRawApps converts constants into the raw app values Z3 expects for
+// bound variables.
+func toRawApps(rawCtx C.Z3_context, args []*AST) []C.Z3_app {
+	result := make([]C.Z3_app, len(args))
+	for i, arg := range args {
+		result[i] = C.Z3_to_app(rawCtx, arg.rawAST)
+	}
+
+	return result
+}
+
+//-------------------------------------------------------------------
+// Quantifier Readers
+//-------------------------------------------------------------------
+
+// IsQuantifier returns true if the AST is a quantifier or lambda. The
+// other quantifier readers may only be called if this is true.
+func (a *AST) IsQuantifier() bool {
+	return C.Z3_get_ast_kind(a.rawCtx, a.rawAST) == C.Z3_QUANTIFIER_AST
+}
+
+// QuantifierKind returns whether the quantifier is universal,
+// existential or a lambda.
+//
+// Maps: Z3_is_quantifier_forall, Z3_is_quantifier_exists
+func (a *AST) QuantifierKind() QuantifierKind {
+	switch {
+	case bool(C.Z3_is_quantifier_forall(a.rawCtx, a.rawAST)):
+		return QuantifierForAll
+	case bool(C.Z3_is_quantifier_exists(a.rawCtx, a.rawAST)):
+		return QuantifierExists
+	default:
+		return QuantifierLambda
+	}
+}
+
+// QuantifierBody returns the body of the quantifier. Within the body,
+// the bound variables are referenced by their de-Bruijn index.
+//
+// Maps: Z3_get_quantifier_body
+func (a *AST) QuantifierBody() *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_get_quantifier_body(a.rawCtx, a.rawAST),
+	}
+}
+
+// QuantifierNumBound returns the number of variables bound by the
+// quantifier.
+//
+// Maps: Z3_get_quantifier_num_bound
+func (a *AST) QuantifierNumBound() uint {
+	return uint(C.Z3_get_quantifier_num_bound(a.rawCtx, a.rawAST))
+}
+
+// QuantifierBoundName returns the name of the bound variable at index i.
+// i must be less than QuantifierNumBound.
+//
+// Maps: Z3_get_quantifier_bound_name
+func (a *AST) QuantifierBoundName(i uint) *Symbol {
+	return &Symbol{
+		rawCtx:    a.rawCtx,
+		rawSymbol: C.Z3_get_quantifier_bound_name(a.rawCtx, a.rawAST, C.uint(i)),
+	}
+}
+
+// QuantifierBoundSort returns the type of the bound variable at index
+// i. i must be less than QuantifierNumBound.
+//
+// Maps: Z3_get_quantifier_bound_sort
+func (a *AST) QuantifierBoundSort(i uint) *Sort {
+	return &Sort{
+		rawCtx:  a.rawCtx,
+		rawSort: C.Z3_get_quantifier_bound_sort(a.rawCtx, a.rawAST, C.uint(i)),
+	}
+}
+
+// QuantifierWeight returns the weight of the quantifier.
+//
+// Maps: Z3_get_quantifier_weight
+func (a *AST) QuantifierWeight() uint {
+	return uint(C.Z3_get_quantifier_weight(a.rawCtx, a.rawAST))
+}
+
+// QuantifierPatterns returns the trigger patterns of the quantifier.
+//
+// Maps: Z3_get_quantifier_pattern_ast
+func (a *AST) QuantifierPatterns() []*Pattern {
+	n := C.Z3_get_quantifier_num_patterns(a.rawCtx, a.rawAST)
+	result := make([]*Pattern, n)
+	for i := C.uint(0); i < n; i++ {
+		result[i] = &Pattern{
+			rawCtx:     a.rawCtx,
+			rawPattern: C.Z3_get_quantifier_pattern_ast(a.rawCtx, a.rawAST, i),
+		}
+	}
+
+	return result
+}
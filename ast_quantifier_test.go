@@ -0,0 +1,174 @@
+package z3
+
+import (
+	"testing"
+)
+
+func TestForAll(t *testing.T) {
+	config := NewConfig()
+	defer config.Close()
+	ctx := NewContext(config)
+	defer ctx.Close()
+
+	x := ctx.Const(ctx.Symbol("x"), ctx.IntSort())
+	raw := ctx.ForAll([]*AST{x}, x.Ge(ctx.Int(0, ctx.IntSort())))
+
+	actual := raw.String()
+	if actual != "(forall ((x Int)) (>= x 0))" {
+		t.Fatalf("bad:\n%s", actual)
+	}
+
+	// Read it back
+	if !raw.IsQuantifier() {
+		t.Fatal("should be quantifier")
+	}
+	if v := raw.QuantifierKind(); v != QuantifierForAll {
+		t.Fatalf("bad: %d", v)
+	}
+	if v := raw.QuantifierNumBound(); v != 1 {
+		t.Fatalf("bad: %d", v)
+	}
+	if v := raw.QuantifierBoundName(0).String(); v != "x" {
+		t.Fatalf("bad: %s", v)
+	}
+	if v := raw.QuantifierBoundSort(0).String(); v != "Int" {
+		t.Fatalf("bad: %s", v)
+	}
+	if v := raw.QuantifierBody().String(); v != "(>= (:var 0) 0)" {
+		t.Fatalf("bad: %s", v)
+	}
+	if x.IsQuantifier() {
+		t.Fatal("should not be quantifier")
+	}
+}
+
+func TestForAllEx(t *testing.T) {
+	config := NewConfig()
+	defer config.Close()
+	ctx := NewContext(config)
+	defer ctx.Close()
+
+	// Create the solver
+	s := ctx.NewSolver()
+	defer s.Close()
+
+	// forall i. 0 <= i < 10 => a[i] >= 0, triggered on a[i]
+	a := ctx.Const(ctx.Symbol("a"), ctx.ArraySort(ctx.IntSort(), ctx.IntSort()))
+	i := ctx.Const(ctx.Symbol("i"), ctx.IntSort())
+	zero := ctx.Int(0, ctx.IntSort())
+	q := ctx.ForAllEx(
+		[]*AST{i},
+		zero.Le(i).And(i.Lt(ctx.Int(10, ctx.IntSort()))).
+			Implies(a.Select(i).Ge(zero)),
+		&QuantifierOptions{
+			Weight:   2,
+			Patterns: []*Pattern{ctx.Pattern(a.Select(i))},
+		})
+	s.Assert(q)
+
+	if v := q.QuantifierWeight(); v != 2 {
+		t.Fatalf("bad: %d", v)
+	}
+	if v := len(q.QuantifierPatterns()); v != 1 {
+		t.Fatalf("bad: %d", v)
+	}
+
+	// a[3] < 0 contradicts the invariant
+	s.Push()
+	s.Assert(a.Select(ctx.Int(3, ctx.IntSort())).Lt(zero))
+	if v := s.Check(); v != False {
+		t.Fatalf("bad: %v", v)
+	}
+	s.Pop(1)
+
+	// a[10] < 0 is outside of the range
+	s.Assert(a.Select(ctx.Int(10, ctx.IntSort())).Lt(zero))
+	if v := s.Check(); v != True {
+		t.Fatalf("bad: %v", v)
+	}
+}
+
+func TestExists(t *testing.T) {
+	config := NewConfig()
+	defer config.Close()
+	ctx := NewContext(config)
+	defer ctx.Close()
+
+	// Create the solver
+	s := ctx.NewSolver()
+	defer s.Close()
+
+	// Not (exists x. x > 0) is unsatisfiable
+	x := ctx.Const(ctx.Symbol("x"), ctx.IntSort())
+	q := ctx.Exists([]*AST{x}, x.Gt(ctx.Int(0, ctx.IntSort())))
+	if v := q.QuantifierKind(); v != QuantifierExists {
+		t.Fatalf("bad: %d", v)
+	}
+
+	s.Assert(q.Not())
+	if v := s.Check(); v != False {
+		t.Fatalf("bad: %v", v)
+	}
+}
+
+func TestLambda(t *testing.T) {
+	config := NewConfig()
+	defer config.Close()
+	ctx := NewContext(config)
+	defer ctx.Close()
+
+	// Create the solver
+	s := ctx.NewSolver()
+	defer s.Close()
+
+	// (lambda x. x + 1)[4] = 5
+	x := ctx.Const(ctx.Symbol("x"), ctx.IntSort())
+	f := ctx.Lambda([]*AST{x}, x.Add(ctx.Int(1, ctx.IntSort())))
+	if v := f.QuantifierKind(); v != QuantifierLambda {
+		t.Fatalf("bad: %d", v)
+	}
+
+	s.Assert(f.Select(ctx.Int(4, ctx.IntSort())).Eq(ctx.Int(5, ctx.IntSort())).Not())
+	if v := s.Check(); v != False {
+		t.Fatalf("bad: %v", v)
+	}
+}
+
+func TestQuantifierNoBound(t *testing.T) {
+	config := NewConfig()
+	defer config.Close()
+	ctx := NewContext(config)
+	defer ctx.Close()
+
+	x := ctx.Const(ctx.Symbol("x"), ctx.IntSort())
+	body := x.Gt(ctx.Int(0, ctx.IntSort()))
+
+	// An empty bound list is an error, not a panic
+	cases := map[string]func() *AST{
+		"forall": func() *AST { return ctx.ForAll(nil, body) },
+		"exists": func() *AST { return ctx.Exists([]*AST{}, body) },
+		"lambda": func() *AST { return ctx.Lambda(nil, body) },
+	}
+	for name, f := range cases {
+		f()
+		if err := ctx.Err(); err == nil {
+			t.Fatalf("%s: should error", name)
+		}
+	}
+}
+
+func TestPatternEmpty(t *testing.T) {
+	config := NewConfig()
+	defer config.Close()
+	ctx := NewContext(config)
+	defer ctx.Close()
+
+	ctx.Pattern()
+	err := ctx.Err()
+	if err == nil {
+		t.Fatal("should error")
+	}
+	if zerr := err.(*Error); zerr.Code != ErrorCodeInvalidPattern {
+		t.Fatalf("bad: %d", zerr.Code)
+	}
+}
@@ -0,0 +1,132 @@
+package z3
+
+import (
+	"unsafe"
+)
+
+// #include "go-z3.h"
+import "C"
+
+// EmptySet creates the set of the given element type with no members.
+//
+// Maps: Z3_mk_empty_set
+func (c *Context) EmptySet(elem *Sort) *AST {
+	return &AST{
+		rawCtx: c.raw,
+		rawAST: C.Z3_mk_empty_set(c.raw, elem.rawSort),
+	}
+}
+
+// FullSet creates the set of the given element type containing every
+// value of that type.
+//
+// Maps: Z3_mk_full_set
+func (c *Context) FullSet(elem *Sort) *AST {
+	return &AST{
+		rawCtx: c.raw,
+		rawAST: C.Z3_mk_full_set(c.raw, elem.rawSort),
+	}
+}
+
+// SetAdd creates an AST node representing the set a with elem added.
+//
+// Maps to: Z3_mk_set_add
+func (a *AST) SetAdd(elem *AST) *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_set_add(a.rawCtx, a.rawAST, elem.rawAST),
+	}
+}
+
+// SetDel creates an AST node representing the set a with elem removed.
+//
+// Maps to: Z3_mk_set_del
+func (a *AST) SetDel(elem *AST) *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_set_del(a.rawCtx, a.rawAST, elem.rawAST),
+	}
+}
+
+// SetUnion creates an AST node representing the union of a and args.
+//
+// Maps to: Z3_mk_set_union
+func (a *AST) SetUnion(args ...*AST) *AST {
+	raws := make([]C.Z3_ast, len(args)+1)
+	raws[0] = a.rawAST
+	for i, arg := range args {
+		raws[i+1] = arg.rawAST
+	}
+
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_set_union(
+			a.rawCtx,
+			C.uint(len(raws)),
+			(*C.Z3_ast)(unsafe.Pointer(&raws[0]))),
+	}
+}
+
+// SetIntersect creates an AST node representing the intersection of a
+// and args.
+//
+// Maps to: Z3_mk_set_intersect
+func (a *AST) SetIntersect(args ...*AST) *AST {
+	raws := make([]C.Z3_ast, len(args)+1)
+	raws[0] = a.rawAST
+	for i, arg := range args {
+		raws[i+1] = arg.rawAST
+	}
+
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_set_intersect(
+			a.rawCtx,
+			C.uint(len(raws)),
+			(*C.Z3_ast)(unsafe.Pointer(&raws[0]))),
+	}
+}
+
+// SetDifference creates an AST node representing the members of a that
+// aren't members of a2.
+//
+// Maps to: Z3_mk_set_difference
+func (a *AST) SetDifference(a2 *AST) *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_set_difference(a.rawCtx, a.rawAST, a2.rawAST),
+	}
+}
+
+// SetComplement creates an AST node representing every value of the
+// element type that isn't a member of a.
+//
+// Maps to: Z3_mk_set_complement
+func (a *AST) SetComplement() *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_set_complement(a.rawCtx, a.rawAST),
+	}
+}
+
+// SetMember creates a predicate that is true if elem is a member of the
+// set a.
+//
+// Maps to: Z3_mk_set_member
+func (a *AST) SetMember(elem *AST) *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_set_member(a.rawCtx, elem.rawAST, a.rawAST),
+	}
+}
+
+// SetSubset creates a predicate that is true if every member of a is
+// also a member of a2.
+//
+// Maps to: Z3_mk_set_subset
+func (a *AST) SetSubset(a2 *AST) *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_set_subset(a.rawCtx, a.rawAST, a2.rawAST),
+	}
+}
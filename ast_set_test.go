@@ -0,0 +1,108 @@
+package z3
+
+import (
+	"sort"
+	"testing"
+)
+
+func TestASTSetMember(t *testing.T) {
+	config := NewConfig()
+	defer config.Close()
+	ctx := NewContext(config)
+	defer ctx.Close()
+
+	// Create a set
+	a := ctx.Const(ctx.Symbol("a"), ctx.SetSort(ctx.IntSort()))
+	i := ctx.Const(ctx.Symbol("i"), ctx.IntSort())
+
+	raw := a.SetMember(i)
+
+	actual := raw.String()
+	if actual != "(select a i)" {
+		t.Fatalf("bad:\n%s", actual)
+	}
+}
+
+func TestASTSetSubset(t *testing.T) {
+	config := NewConfig()
+	defer config.Close()
+	ctx := NewContext(config)
+	defer ctx.Close()
+
+	// Create the solver
+	s := ctx.NewSolver()
+	defer s.Close()
+
+	// {1, 2} minus {2} is a subset of {1} but {1, 2} isn't
+	one := ctx.Int(1, ctx.IntSort())
+	two := ctx.Int(2, ctx.IntSort())
+	a := ctx.EmptySet(ctx.IntSort()).SetAdd(one).SetAdd(two)
+	b := ctx.EmptySet(ctx.IntSort()).SetAdd(one)
+	s.Assert(a.SetDifference(ctx.EmptySet(ctx.IntSort()).SetAdd(two)).SetSubset(b))
+	s.Assert(a.SetSubset(b).Not())
+	s.Assert(a.SetUnion(b).SetComplement().SetMember(one).Not())
+
+	if v := s.Check(); v != True {
+		t.Fatalf("bad: %v", v)
+	}
+}
+
+func TestModelSetMembers(t *testing.T) {
+	config := NewConfig()
+	defer config.Close()
+	ctx := NewContext(config)
+	defer ctx.Close()
+
+	// Create the solver
+	s := ctx.NewSolver()
+	defer s.Close()
+
+	// roles = ({1, 2, 3} \ {2}) ∩ ({1, 3, 4} ∪ {5})
+	elem := ctx.IntSort()
+	set := func(vs ...int) *AST {
+		result := ctx.EmptySet(elem)
+		for _, v := range vs {
+			result = result.SetAdd(ctx.Int(v, elem))
+		}
+		return result
+	}
+	roles := ctx.Const(ctx.Symbol("roles"), ctx.SetSort(elem))
+	other := ctx.Const(ctx.Symbol("other"), ctx.SetSort(elem))
+	s.Assert(roles.Eq(set(1, 2, 3).SetDel(ctx.Int(2, elem)).SetIntersect(set(1, 3, 4).SetUnion(set(5)))))
+	s.Assert(other.Eq(roles.SetComplement()))
+
+	if v := s.Check(); v != True {
+		t.Fatalf("bad: %v", v)
+	}
+
+	// Get the model
+	m := s.Model()
+	defer m.Close()
+
+	members, ok := m.SetMembers(roles)
+	if !ok {
+		t.Fatal("should be finite")
+	}
+
+	var actual []int
+	for _, v := range members {
+		actual = append(actual, v.Int())
+	}
+	sort.Ints(actual)
+	if len(actual) != 2 || actual[0] != 1 || actual[1] != 3 {
+		t.Fatalf("bad: %v", actual)
+	}
+
+	// The complement is infinite
+	if _, ok := m.SetMembers(other); ok {
+		t.Fatal("should not be finite")
+	}
+
+	// The full set is infinite and the empty set has no members
+	if _, ok := m.SetMembers(ctx.FullSet(elem)); ok {
+		t.Fatal("should not be finite")
+	}
+	if v, ok := m.SetMembers(ctx.EmptySet(elem)); !ok || len(v) != 0 {
+		t.Fatalf("bad: %v", v)
+	}
+}
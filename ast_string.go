@@ -0,0 +1,441 @@
+package z3
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+	"unsafe"
+)
+
+// #include <stdlib.h>
+// #include "go-z3.h"
+import "C"
+
+//-------------------------------------------------------------------
+// String and Sequence Literals
+//-------------------------------------------------------------------
+
+// StringVal creates a string value. Any Go string may be used; characters
+// are escaped as needed before being passed to Z3.
+//
+// Maps: Z3_mk_string
+func (c *Context) StringVal(v string) *AST {
+	// Z3 interprets backslash escapes in the input and doesn't accept
+	// raw non-ASCII bytes, so everything but printable ASCII is escaped.
+	var b strings.Builder
+	for _, r := range v {
+		if r >= 0x20 && r < 0x7f && r != '\\' {
+			b.WriteRune(r)
+			continue
+		}
+
+		fmt.Fprintf(&b, "\\u{%x}", r)
+	}
+
+	cs := C.CString(b.String())
+	defer C.free(unsafe.Pointer(cs))
+
+	return &AST{
+		rawCtx: c.raw,
+		rawAST: C.Z3_mk_string(c.raw, cs),
+	}
+}
+
+// SeqEmpty creates the empty sequence of the given sequence type.
+//
+// Maps: Z3_mk_seq_empty
+func (c *Context) SeqEmpty(typ *Sort) *AST {
+	return &AST{
+		rawCtx: c.raw,
+		rawAST: C.Z3_mk_seq_empty(c.raw, typ.rawSort),
+	}
+}
+
+// SeqUnit creates a sequence containing only the element a.
+//
+// Maps to: Z3_mk_seq_unit
+func (a *AST) SeqUnit() *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_seq_unit(a.rawCtx, a.rawAST),
+	}
+}
+
+// StringValue returns the value of a string literal as a Go string, with
+// any escapes decoded. The second return value is false if the AST isn't
+// a string literal, such as a string constant that hasn't been evaluated
+// within a Model.
+//
+// Maps: Z3_get_string
+func (a *AST) StringValue() (string, bool) {
+	if !bool(C.Z3_is_string(a.rawCtx, a.rawAST)) {
+		return "", false
+	}
+
+	raw := C.GoString(C.Z3_get_string(a.rawCtx, a.rawAST))
+	if !strings.Contains(raw, "\\") {
+		return raw, true
+	}
+
+	// Z3 escapes characters outside of printable ASCII as \u{...} but
+	// doesn't escape backslashes, so the text \u{41} could either be
+	// an escaped "A" or literally what the string contains. Characters
+	// read one at a time are unambiguous, so fall back to that.
+	n := (&AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_simplify(a.rawCtx, a.Length().rawAST),
+	}).Int()
+	intSort := C.Z3_mk_int_sort(a.rawCtx)
+
+	var b strings.Builder
+	for i := 0; i < n; i++ {
+		ch := C.Z3_simplify(a.rawCtx, C.Z3_mk_seq_at(
+			a.rawCtx, a.rawAST, C.Z3_mk_int(a.rawCtx, C.int(i), intSort)))
+		b.WriteRune(decodeZ3Char(C.GoString(C.Z3_get_string(a.rawCtx, ch))))
+	}
+
+	return b.String(), true
+}
+
+// decodeZ3Char decodes a single character as printed by Z3_get_string.
+func decodeZ3Char(v string) rune {
+	if len(v) > 4 && strings.HasPrefix(v, "\\u{") && strings.HasSuffix(v, "}") {
+		if r, err := strconv.ParseUint(v[3:len(v)-1], 16, 32); err == nil {
+			return rune(r)
+		}
+	}
+
+	r, _ := utf8.DecodeRuneInString(v)
+	return r
+}
+
+//-------------------------------------------------------------------
+// String and Sequence Operations
+//-------------------------------------------------------------------
+
+// Concat creates an AST node representing the concatenation of the
+// strings or sequences a, args[0], ... args[N].
+//
+// All AST values must be part of the same context.
+//
+// Maps to: Z3_mk_seq_concat
+func (a *AST) Concat(args ...*AST) *AST {
+	raws := make([]C.Z3_ast, len(args)+1)
+	raws[0] = a.rawAST
+	for i, arg := range args {
+		raws[i+1] = arg.rawAST
+	}
+
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_seq_concat(
+			a.rawCtx,
+			C.uint(len(raws)),
+			(*C.Z3_ast)(unsafe.Pointer(&raws[0]))),
+	}
+}
+
+// Length creates an AST node representing the length of the string or
+// sequence a.
+//
+// Maps to: Z3_mk_seq_length
+func (a *AST) Length() *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_seq_length(a.rawCtx, a.rawAST),
+	}
+}
+
+// Contains creates an AST node representing whether a contains sub.
+//
+// Maps to: Z3_mk_seq_contains
+func (a *AST) Contains(sub *AST) *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_seq_contains(a.rawCtx, a.rawAST, sub.rawAST),
+	}
+}
+
+// PrefixOf creates an AST node representing whether a is a prefix of s.
+//
+// Maps to: Z3_mk_seq_prefix
+func (a *AST) PrefixOf(s *AST) *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_seq_prefix(a.rawCtx, a.rawAST, s.rawAST),
+	}
+}
+
+// SuffixOf creates an AST node representing whether a is a suffix of s.
+//
+// Maps to: Z3_mk_seq_suffix
+func (a *AST) SuffixOf(s *AST) *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_seq_suffix(a.rawCtx, a.rawAST, s.rawAST),
+	}
+}
+
+// IndexOf creates an AST node representing the index of the first
+// occurrence of sub in a at or after offset, or -1 if there is none.
+//
+// Maps to: Z3_mk_seq_index
+func (a *AST) IndexOf(sub, offset *AST) *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_seq_index(a.rawCtx, a.rawAST, sub.rawAST, offset.rawAST),
+	}
+}
+
+// Substring creates an AST node representing the substring of a
+// starting at offset with the given length.
+//
+// Maps to: Z3_mk_seq_extract
+func (a *AST) Substring(offset, length *AST) *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_seq_extract(a.rawCtx, a.rawAST, offset.rawAST, length.rawAST),
+	}
+}
+
+// Replace creates an AST node representing a with the first occurrence
+// of src replaced by dst.
+//
+// Maps to: Z3_mk_seq_replace
+func (a *AST) Replace(src, dst *AST) *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_seq_replace(a.rawCtx, a.rawAST, src.rawAST, dst.rawAST),
+	}
+}
+
+// At creates an AST node representing the unit string or sequence at
+// index in a.
+//
+// Maps to: Z3_mk_seq_at
+func (a *AST) At(index *AST) *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_seq_at(a.rawCtx, a.rawAST, index.rawAST),
+	}
+}
+
+// StrLt creates an AST node representing whether the string a is
+// lexicographically less than s.
+//
+// Maps to: Z3_mk_str_lt
+func (a *AST) StrLt(s *AST) *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_str_lt(a.rawCtx, a.rawAST, s.rawAST),
+	}
+}
+
+// StrLe creates an AST node representing whether the string a is
+// lexicographically less than or equal to s.
+//
+// Maps to: Z3_mk_str_le
+func (a *AST) StrLe(s *AST) *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_str_le(a.rawCtx, a.rawAST, s.rawAST),
+	}
+}
+
+// StrToInt creates an AST node representing the string a converted to a
+// non-negative integer, or -1 if a isn't a sequence of digits.
+//
+// Maps to: Z3_mk_str_to_int
+func (a *AST) StrToInt() *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_str_to_int(a.rawCtx, a.rawAST),
+	}
+}
+
+// IntToStr creates an AST node representing the non-negative integer a
+// converted to a string. Negative values result in the empty string.
+//
+// Maps to: Z3_mk_int_to_str
+func (a *AST) IntToStr() *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_int_to_str(a.rawCtx, a.rawAST),
+	}
+}
+
+//-------------------------------------------------------------------
+// Regular Expressions
+//-------------------------------------------------------------------
+
+// ReEmpty creates the regular expression of the given type that matches
+// nothing.
+//
+// Maps: Z3_mk_re_empty
+func (c *Context) ReEmpty(typ *Sort) *AST {
+	return &AST{
+		rawCtx: c.raw,
+		rawAST: C.Z3_mk_re_empty(c.raw, typ.rawSort),
+	}
+}
+
+// ReFull creates the regular expression of the given type that matches
+// everything.
+//
+// Maps: Z3_mk_re_full
+func (c *Context) ReFull(typ *Sort) *AST {
+	return &AST{
+		rawCtx: c.raw,
+		rawAST: C.Z3_mk_re_full(c.raw, typ.rawSort),
+	}
+}
+
+// ReRange creates a regular expression matching a single character
+// between lo and hi inclusive. lo and hi must be strings of length 1.
+//
+// Maps: Z3_mk_re_range
+func (c *Context) ReRange(lo, hi *AST) *AST {
+	return &AST{
+		rawCtx: c.raw,
+		rawAST: C.Z3_mk_re_range(c.raw, lo.rawAST, hi.rawAST),
+	}
+}
+
+// ToRe creates an AST node representing the regular expression that
+// matches exactly the string or sequence a.
+//
+// Maps to: Z3_mk_seq_to_re
+func (a *AST) ToRe() *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_seq_to_re(a.rawCtx, a.rawAST),
+	}
+}
+
+// InRe creates an AST node representing whether the string or
+// sequence a matches the regular expression re.
+//
+// Maps to: Z3_mk_seq_in_re
+func (a *AST) InRe(re *AST) *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_seq_in_re(a.rawCtx, a.rawAST, re.rawAST),
+	}
+}
+
+// ReStar creates an AST node representing zero or more repetitions of the
+// regular expression a.
+//
+// Maps to: Z3_mk_re_star
+func (a *AST) ReStar() *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_re_star(a.rawCtx, a.rawAST),
+	}
+}
+
+// RePlus creates an AST node representing one or more repetitions of the
+// regular expression a.
+//
+// Maps to: Z3_mk_re_plus
+func (a *AST) RePlus() *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_re_plus(a.rawCtx, a.rawAST),
+	}
+}
+
+// ReOption creates an AST node representing zero or one occurrence of the
+// regular expression a.
+//
+// Maps to: Z3_mk_re_option
+func (a *AST) ReOption() *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_re_option(a.rawCtx, a.rawAST),
+	}
+}
+
+// ReComplement creates an AST node representing the complement of the
+// regular expression a.
+//
+// Maps to: Z3_mk_re_complement
+func (a *AST) ReComplement() *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_re_complement(a.rawCtx, a.rawAST),
+	}
+}
+
+// ReLoop creates an AST node representing between lo and hi repetitions
+// of the regular expression a. A hi of zero means there is no upper
+// bound.
+//
+// Maps to: Z3_mk_re_loop
+func (a *AST) ReLoop(lo, hi uint) *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_re_loop(a.rawCtx, a.rawAST, C.uint(lo), C.uint(hi)),
+	}
+}
+
+// ReUnion creates an AST node representing the union of the regular
+// expressions a, args[0], ... args[N].
+//
+// Maps to: Z3_mk_re_union
+func (a *AST) ReUnion(args ...*AST) *AST {
+	raws := make([]C.Z3_ast, len(args)+1)
+	raws[0] = a.rawAST
+	for i, arg := range args {
+		raws[i+1] = arg.rawAST
+	}
+
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_re_union(
+			a.rawCtx,
+			C.uint(len(raws)),
+			(*C.Z3_ast)(unsafe.Pointer(&raws[0]))),
+	}
+}
+
+// ReConcat creates an AST node representing the concatenation of the
+// regular expressions a, args[0], ... args[N].
+//
+// Maps to: Z3_mk_re_concat
+func (a *AST) ReConcat(args ...*AST) *AST {
+	raws := make([]C.Z3_ast, len(args)+1)
+	raws[0] = a.rawAST
+	for i, arg := range args {
+		raws[i+1] = arg.rawAST
+	}
+
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_re_concat(
+			a.rawCtx,
+			C.uint(len(raws)),
+			(*C.Z3_ast)(unsafe.Pointer(&raws[0]))),
+	}
+}
+
+// ReIntersect creates an AST node representing the intersection of the
+// regular expressions a, args[0], ... args[N].
+//
+// Maps to: Z3_mk_re_intersect
+func (a *AST) ReIntersect(args ...*AST) *AST {
+	raws := make([]C.Z3_ast, len(args)+1)
+	raws[0] = a.rawAST
+	for i, arg := range args {
+		raws[i+1] = arg.rawAST
+	}
+
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_mk_re_intersect(
+			a.rawCtx,
+			C.uint(len(raws)),
+			(*C.Z3_ast)(unsafe.Pointer(&raws[0]))),
+	}
+}
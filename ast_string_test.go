@@ -0,0 +1,131 @@
+package z3
+
+import (
+	"testing"
+)
+
+func TestASTConcat(t *testing.T) {
+	config := NewConfig()
+	defer config.Close()
+	ctx := NewContext(config)
+	defer ctx.Close()
+
+	x := ctx.Const(ctx.Symbol("x"), ctx.StringSort())
+	raw := x.Concat(ctx.StringVal("abc"))
+
+	actual := raw.String()
+	if actual != `(str.++ x "abc")` {
+		t.Fatalf("bad:\n%s", actual)
+	}
+}
+
+func TestASTStringValue(t *testing.T) {
+	config := NewConfig()
+	defer config.Close()
+	ctx := NewContext(config)
+	defer ctx.Close()
+
+	cases := []string{
+		"",
+		"hello",
+		"line\nbreak\ttab",
+		`back\slash`,
+		`\u{41}`,
+		`"quoted"`,
+		"café ☃ \U0001F600",
+	}
+
+	for _, tc := range cases {
+		v, ok := ctx.StringVal(tc).StringValue()
+		if !ok {
+			t.Fatalf("%q: not a string", tc)
+		}
+		if v != tc {
+			t.Fatalf("bad: %q != %q", v, tc)
+		}
+	}
+
+	// Non-literals aren't strings
+	x := ctx.Const(ctx.Symbol("x"), ctx.StringSort())
+	if _, ok := x.StringValue(); ok {
+		t.Fatal("should not be a string")
+	}
+}
+
+func TestASTStringSolve(t *testing.T) {
+	config := NewConfig()
+	defer config.Close()
+	ctx := NewContext(config)
+	defer ctx.Close()
+
+	// Create the solver
+	s := ctx.NewSolver()
+	defer s.Close()
+
+	// x starts with "id-", is 6 long, ends in digits that parse to 42
+	// and contains a backslash followed by a non-ASCII character.
+	x := ctx.Const(ctx.Symbol("x"), ctx.StringSort())
+	y := ctx.Const(ctx.Symbol("y"), ctx.StringSort())
+	s.Assert(ctx.StringVal("id-").PrefixOf(x))
+	s.Assert(x.Length().Eq(ctx.Int(6, ctx.IntSort())))
+	s.Assert(x.Substring(ctx.Int(4, ctx.IntSort()), ctx.Int(2, ctx.IntSort())).
+		StrToInt().Eq(ctx.Int(42, ctx.IntSort())))
+	s.Assert(y.Eq(x.Replace(ctx.StringVal("id-"), ctx.StringVal("\\é"))))
+
+	if v := s.Check(); v != True {
+		t.Fatalf("bad: %v", v)
+	}
+
+	m := s.Model()
+	defer m.Close()
+
+	xv, ok := m.Eval(x).StringValue()
+	if !ok || len(xv) != 6 || xv[:3] != "id-" || xv[4:] != "42" {
+		t.Fatalf("bad: %q", xv)
+	}
+
+	yv, ok := m.Eval(y).StringValue()
+	if !ok || yv != "\\é"+xv[3:] {
+		t.Fatalf("bad: %q", yv)
+	}
+}
+
+func TestASTInRe(t *testing.T) {
+	config := NewConfig()
+	defer config.Close()
+	ctx := NewContext(config)
+	defer ctx.Close()
+
+	// Create the solver
+	s := ctx.NewSolver()
+	defer s.Close()
+
+	// [a-z]+@example\.(com|org)
+	lower := ctx.ReRange(ctx.StringVal("a"), ctx.StringVal("z")).RePlus()
+	domain := ctx.StringVal("@example.").ToRe()
+	tld := ctx.StringVal("com").ToRe().ReUnion(ctx.StringVal("org").ToRe())
+	re := lower.ReConcat(domain, tld)
+
+	x := ctx.Const(ctx.Symbol("x"), ctx.StringSort())
+	s.Assert(x.InRe(re))
+	s.Assert(x.Length().Eq(ctx.Int(15, ctx.IntSort())))
+	s.Assert(x.Contains(ctx.StringVal(".org")))
+
+	if v := s.Check(); v != True {
+		t.Fatalf("bad: %v", v)
+	}
+
+	m := s.Model()
+	defer m.Close()
+
+	v, ok := m.Eval(x).StringValue()
+	if !ok || len(v) != 15 || v[3:] != "@example.org" {
+		t.Fatalf("bad: %q", v)
+	}
+
+	// Uppercase doesn't match
+	s.Assert(x.Eq(ctx.StringVal("ABC@example.org")))
+	if v := s.Check(); v != False {
+		t.Fatalf("bad: %v", v)
+	}
+}
@@ -0,0 +1,67 @@
+package z3
+
+import (
+	"unsafe"
+)
+
+// #include "go-z3.h"
+import "C"
+
+// Substitute creates a copy of a with every occurrence of from[i]
+// replaced by to[i]. from and to must have the same length and each
+// to[i] must have the same type as from[i]. The terms in from can be
+// arbitrary subterms, not only constants.
+//
+// If from and to differ in length, an ErrorCodeInvalidArg error is
+// recorded on the Context and a is returned unchanged.
+//
+// Maps to: Z3_substitute
+func (a *AST) Substitute(from, to []*AST) *AST {
+	if len(from) != len(to) {
+		C.Z3_set_error(a.rawCtx, C.Z3_INVALID_ARG)
+		return a
+	}
+	if len(from) == 0 {
+		return a
+	}
+
+	rawFrom := make([]C.Z3_ast, len(from))
+	rawTo := make([]C.Z3_ast, len(to))
+	for i := range from {
+		rawFrom[i] = from[i].rawAST
+		rawTo[i] = to[i].rawAST
+	}
+
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_substitute(
+			a.rawCtx, a.rawAST,
+			C.uint(len(rawFrom)),
+			(*C.Z3_ast)(unsafe.Pointer(&rawFrom[0])),
+			(*C.Z3_ast)(unsafe.Pointer(&rawTo[0]))),
+	}
+}
+
+// SubstituteVars creates a copy of a with every free bound variable with
+// de-Bruijn index i replaced by to[i]. This instantiates the body of a
+// quantifier, as returned by QuantifierBody, with concrete terms.
+//
+// Maps to: Z3_substitute_vars
+func (a *AST) SubstituteVars(to []*AST) *AST {
+	if len(to) == 0 {
+		return a
+	}
+
+	raws := make([]C.Z3_ast, len(to))
+	for i, v := range to {
+		raws[i] = v.rawAST
+	}
+
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_substitute_vars(
+			a.rawCtx, a.rawAST,
+			C.uint(len(raws)),
+			(*C.Z3_ast)(unsafe.Pointer(&raws[0]))),
+	}
+}
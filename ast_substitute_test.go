@@ -0,0 +1,77 @@
+package z3
+
+import (
+	"testing"
+)
+
+func TestASTSubstitute(t *testing.T) {
+	config := NewConfig()
+	defer config.Close()
+	ctx := NewContext(config)
+	defer ctx.Close()
+
+	// Template: x + y > limit
+	x := ctx.Const(ctx.Symbol("x"), ctx.IntSort())
+	y := ctx.Const(ctx.Symbol("y"), ctx.IntSort())
+	limit := ctx.Const(ctx.Symbol("limit"), ctx.IntSort())
+	template := x.Add(y).Gt(limit)
+
+	// Instantiate limit and replace the subterm x + y
+	a := ctx.Const(ctx.Symbol("a"), ctx.IntSort())
+	raw := template.Substitute(
+		[]*AST{limit, x.Add(y)},
+		[]*AST{ctx.Int(10, ctx.IntSort()), a.Mul(ctx.Int(2, ctx.IntSort()))})
+
+	actual := raw.String()
+	if actual != "(> (* a 2) 10)" {
+		t.Fatalf("bad:\n%s", actual)
+	}
+
+	// The template is unchanged
+	if v := template.String(); v != "(> (+ x y) limit)" {
+		t.Fatalf("bad:\n%s", v)
+	}
+}
+
+func TestASTSubstitute_lengthMismatch(t *testing.T) {
+	config := NewConfig()
+	defer config.Close()
+	ctx := NewContext(config)
+	defer ctx.Close()
+
+	x := ctx.Const(ctx.Symbol("x"), ctx.IntSort())
+	raw := x.Substitute([]*AST{x}, nil)
+	if v := raw.String(); v != "x" {
+		t.Fatalf("bad: %s", v)
+	}
+
+	err := ctx.Err()
+	if err == nil {
+		t.Fatal("should error")
+	}
+	if zerr := err.(*Error); zerr.Code != ErrorCodeInvalidArg {
+		t.Fatalf("bad: %d", zerr.Code)
+	}
+}
+
+func TestASTSubstituteVars(t *testing.T) {
+	config := NewConfig()
+	defer config.Close()
+	ctx := NewContext(config)
+	defer ctx.Close()
+
+	// forall x y. x < y
+	x := ctx.Const(ctx.Symbol("x"), ctx.IntSort())
+	y := ctx.Const(ctx.Symbol("y"), ctx.IntSort())
+	q := ctx.ForAll([]*AST{x, y}, x.Lt(y))
+
+	// Instantiate the body. The innermost bound variable, y, has index 0.
+	one := ctx.Int(1, ctx.IntSort())
+	two := ctx.Int(2, ctx.IntSort())
+	raw := q.QuantifierBody().SubstituteVars([]*AST{two, one})
+
+	actual := raw.String()
+	if actual != "(< 1 2)" {
+		t.Fatalf("bad:\n%s", actual)
+	}
+}
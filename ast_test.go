@@ -0,0 +1,141 @@
+package z3
+
+import (
+	"math"
+	"strconv"
+	"testing"
+)
+
+func TestASTIntrospection(t *testing.T) {
+	config := NewConfig()
+	defer config.Close()
+	ctx := NewContext(config)
+	defer ctx.Close()
+
+	// x + 2 * y
+	x := ctx.Const(ctx.Symbol("x"), ctx.IntSort())
+	y := ctx.Const(ctx.Symbol("y"), ctx.IntSort())
+	two := ctx.Int(2, ctx.IntSort())
+	raw := x.Add(two.Mul(y))
+
+	if v := raw.Kind(); v != ASTApp {
+		t.Fatalf("bad: %s", v)
+	}
+	if v := raw.Sort().String(); v != "Int" {
+		t.Fatalf("bad: %s", v)
+	}
+	if !raw.IsApp() || raw.IsNumeral() {
+		t.Fatalf("bad: %s", raw)
+	}
+	if v := raw.Decl().Kind(); v != OpAdd {
+		t.Fatalf("bad: %d", v)
+	}
+	if v := raw.NumArgs(); v != 2 {
+		t.Fatalf("bad: %d", v)
+	}
+
+	args := raw.Args()
+	if len(args) != 2 || args[0].ID() != x.ID() {
+		t.Fatalf("bad: %v", args)
+	}
+	if v := args[1].Decl().Kind(); v != OpMul {
+		t.Fatalf("bad: %d", v)
+	}
+	if v := args[1].Arg(0); v.Kind() != ASTNumeral || !v.IsNumeral() || v.Int() != 2 {
+		t.Fatalf("bad: %s", v)
+	}
+
+	// Constants are applications of uninterpreted declarations
+	if v := x.Decl(); v.Kind() != OpUninterpreted || v.Name().String() != "x" {
+		t.Fatalf("bad: %s", v)
+	}
+	if v := x.Args(); v != nil {
+		t.Fatalf("bad: %v", v)
+	}
+
+	// Structurally equal nodes are shared
+	if v := x.Add(two.Mul(y)); v.ID() != raw.ID() || v.Hash() != raw.Hash() {
+		t.Fatalf("bad: %d %d", v.ID(), raw.ID())
+	}
+	if x.ID() == y.ID() {
+		t.Fatal("should differ")
+	}
+}
+
+func TestASTIntrospectionQuantifier(t *testing.T) {
+	config := NewConfig()
+	defer config.Close()
+	ctx := NewContext(config)
+	defer ctx.Close()
+
+	// forall x. x >= 0
+	x := ctx.Const(ctx.Symbol("x"), ctx.IntSort())
+	q := ctx.ForAll([]*AST{x}, x.Ge(ctx.Int(0, ctx.IntSort())))
+
+	if v := q.Kind(); v != ASTQuantifier {
+		t.Fatalf("bad: %s", v)
+	}
+	if q.IsApp() || q.Decl() != nil || q.NumArgs() != 0 {
+		t.Fatalf("bad: %s", q)
+	}
+
+	// The bound variable in the body
+	body := q.QuantifierBody()
+	if v := body.Decl().Kind(); v != OpGe {
+		t.Fatalf("bad: %d", v)
+	}
+	if v := body.Arg(0); v.Kind() != ASTVar || v.VarIndex() != 0 || v.Sort().String() != "Int" {
+		t.Fatalf("bad: %s", v)
+	}
+}
+
+func TestASTEqual(t *testing.T) {
+	config := NewConfig()
+	defer config.Close()
+	ctx := NewContext(config)
+	defer ctx.Close()
+
+	x := ctx.Const(ctx.Symbol("x"), ctx.IntSort())
+	y := ctx.Const(ctx.Symbol("y"), ctx.IntSort())
+
+	// Fresh wrappers for the same term are equal
+	a := x.Add(y)
+	b := x.Add(y)
+	if a == b || !a.Equal(b) {
+		t.Fatalf("bad: %s %s", a, b)
+	}
+
+	// Equal is structural, not semantic
+	if a.Equal(y.Add(x)) {
+		t.Fatal("should not be equal")
+	}
+}
+
+func TestContextReal(t *testing.T) {
+	config := NewConfig()
+	defer config.Close()
+	ctx := NewContext(config)
+	defer ctx.Close()
+
+	if v := ctx.Real(-7, 12).Rat().RatString(); v != "-7/12" {
+		t.Fatalf("bad: %s", v)
+	}
+
+	// Values that don't fit in 32 bits aren't truncated
+	if strconv.IntSize == 32 {
+		t.Skip("int is 32 bits")
+	}
+	n := math.MaxInt32 + 1
+	if v := ctx.Real(n, 3).Rat().RatString(); v != "2147483648/3" {
+		t.Fatalf("bad: %s", v)
+	}
+	if v := ctx.Real(1, -n-1).Rat().RatString(); v != "-1/2147483649" {
+		t.Fatalf("bad: %s", v)
+	}
+
+	// A zero denominator is still an error
+	ctx.Real(n, 0)
+	if err := ctx.Err(); err == nil {
+		t.Fatal("should error")
+	}
+}
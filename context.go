@@ -8,9 +8,16 @@ type Context struct {
 	raw C.Z3_context
 }
 
+// NewContext creates a new context with the given configuration.
+//
+// Errors that occur within the context are recorded and can be retrieved
+// with Err rather than terminating the process.
 func NewContext(c *Config) *Context {
+	raw := C.Z3_mk_context(c.Z3Value())
+	C.Z3_set_error_handler(raw, C._go_z3_error_handler())
+
 	return &Context{
-		raw: C.Z3_mk_context(c.Z3Value()),
+		raw: raw,
 	}
 }
 
@@ -24,6 +31,10 @@ func (c *Context) Close() error {
 	delete(errorHandlerMap, c.raw)
 	errorHandlerMapLock.Unlock()
 
+	errorMapLock.Lock()
+	delete(errorMap, c.raw)
+	errorMapLock.Unlock()
+
 	return nil
 }
 
@@ -31,3 +42,15 @@ func (c *Context) Close() error {
 func (c *Context) Z3Value() C.Z3_context {
 	return c.raw
 }
+
+// Interrupt interrupts the execution of any long running operation in
+// this context, such as Solver.Check. The interrupted operation returns
+// as soon as possible with an undefined result.
+//
+// This is safe to call from a different goroutine than the one
+// performing the operation.
+//
+// Maps: Z3_interrupt
+func (c *Context) Interrupt() {
+	C.Z3_interrupt(c.raw)
+}
@@ -0,0 +1,276 @@
+package z3
+
+import (
+	"unsafe"
+)
+
+// #include "go-z3.h"
+import "C"
+
+// DatatypeSpec describes an algebraic datatype to create with
+// Context.Datatypes.
+type DatatypeSpec struct {
+	Name         string
+	Constructors []ConstructorSpec
+}
+
+// ConstructorSpec describes a single constructor of a datatype.
+type ConstructorSpec struct {
+	// Name is the name of the constructor.
+	Name string
+
+	// Recognizer is the name of the function that tests whether a value
+	// was built with this constructor. If empty, "is-" followed by Name
+	// is used.
+	Recognizer string
+
+	// Fields are the fields of the constructor. A constructor without
+	// fields is a constant, as in an enumeration.
+	Fields []FieldSpec
+}
+
+// FieldSpec describes a single field of a datatype constructor.
+type FieldSpec struct {
+	// Name is the name of the accessor for this field.
+	Name string
+
+	// Sort is the type of the field. If nil, the field refers to a
+	// datatype being declared in the same call, selected by SortRef.
+	Sort *Sort
+
+	// SortRef is the index of the datatype this field refers to within
+	// the declaration when Sort is nil. For Context.Datatype, 0 refers
+	// to the datatype itself.
+	SortRef uint
+}
+
+// Datatype is an algebraic datatype created by Context.Datatype or one
+// of the related functions.
+type Datatype struct {
+	Sort         *Sort
+	Constructors []*Constructor
+}
+
+// Constructor is a constructor of a Datatype along with the functions
+// for working with values built by it.
+type Constructor struct {
+	// Name is the name of the constructor.
+	Name string
+
+	// Decl creates values. Apply it to one argument per field.
+	Decl *FuncDecl
+
+	// Recognizer returns true for values built with this constructor.
+	Recognizer *FuncDecl
+
+	// Accessors return the fields of values built with this
+	// constructor, in the same order as the fields were declared.
+	Accessors []*FuncDecl
+}
+
+// Datatype creates a single algebraic datatype with the given
+// constructors. Fields may refer to the datatype itself to create
+// recursive types such as lists and trees.
+//
+// Maps: Z3_mk_datatypes
+func (c *Context) Datatype(name string, constructors ...ConstructorSpec) *Datatype {
+	return c.Datatypes(DatatypeSpec{
+		Name:         name,
+		Constructors: constructors,
+	})[0]
+}
+
+// EnumSort creates a datatype with a constant constructor for each of
+// the given values.
+//
+// Maps: Z3_mk_datatypes
+func (c *Context) EnumSort(name string, values ...string) *Datatype {
+	constructors := make([]ConstructorSpec, len(values))
+	for i, v := range values {
+		constructors[i] = ConstructorSpec{Name: v}
+	}
+
+	return c.Datatype(name, constructors...)
+}
+
+// TupleSort creates a record datatype with a single constructor of the
+// same name holding the given fields.
+//
+// Maps: Z3_mk_datatypes
+func (c *Context) TupleSort(name string, fields ...FieldSpec) *Datatype {
+	return c.Datatype(name, ConstructorSpec{
+		Name:   name,
+		Fields: fields,
+	})
+}
+
+// Datatypes creates one or more algebraic datatypes at once. Fields may
+// refer to any of the datatypes being declared by index (see FieldSpec),
+// which allows mutually recursive types. The result has one Datatype for
+// each spec, in the same order. With no specs, the result is nil.
+//
+// Maps: Z3_mk_datatypes
+func (c *Context) Datatypes(specs ...DatatypeSpec) []*Datatype {
+	if len(specs) == 0 {
+		return nil
+	}
+
+	rawNames := make([]C.Z3_symbol, len(specs))
+	rawLists := make([]C.Z3_constructor_list, len(specs))
+	rawConstructors := make([][]C.Z3_constructor, len(specs))
+	for i, spec := range specs {
+		rawNames[i] = c.Symbol(spec.Name).rawSymbol
+
+		raws := make([]C.Z3_constructor, len(spec.Constructors))
+		for j, cs := range spec.Constructors {
+			raws[j] = c.newRawConstructor(cs)
+			defer C.Z3_del_constructor(c.raw, raws[j])
+		}
+		rawConstructors[i] = raws
+
+		var rawsPtr *C.Z3_constructor
+		if len(raws) > 0 {
+			rawsPtr = (*C.Z3_constructor)(unsafe.Pointer(&raws[0]))
+		}
+		rawLists[i] = C.Z3_mk_constructor_list(c.raw, C.uint(len(raws)), rawsPtr)
+		defer C.Z3_del_constructor_list(c.raw, rawLists[i])
+	}
+
+	rawSorts := make([]C.Z3_sort, len(specs))
+	C.Z3_mk_datatypes(
+		c.raw,
+		C.uint(len(specs)),
+		(*C.Z3_symbol)(unsafe.Pointer(&rawNames[0])),
+		(*C.Z3_sort)(unsafe.Pointer(&rawSorts[0])),
+		(*C.Z3_constructor_list)(unsafe.Pointer(&rawLists[0])))
+
+	// Now that the datatypes exist, read back the function declarations
+	// for each constructor.
+	result := make([]*Datatype, len(specs))
+	for i, spec := range specs {
+		d := &Datatype{
+			Sort: &Sort{
+				rawCtx:  c.raw,
+				rawSort: rawSorts[i],
+			},
+			Constructors: make([]*Constructor, len(spec.Constructors)),
+		}
+
+		for j, cs := range spec.Constructors {
+			var rawDecl, rawRecognizer C.Z3_func_decl
+			rawAccessors := make([]C.Z3_func_decl, len(cs.Fields))
+			var rawAccessorsPtr *C.Z3_func_decl
+			if len(rawAccessors) > 0 {
+				rawAccessorsPtr = (*C.Z3_func_decl)(unsafe.Pointer(&rawAccessors[0]))
+			}
+			C.Z3_query_constructor(
+				c.raw,
+				rawConstructors[i][j],
+				C.uint(len(cs.Fields)),
+				&rawDecl,
+				&rawRecognizer,
+				rawAccessorsPtr)
+
+			constructor := &Constructor{
+				Name:       cs.Name,
+				Decl:       &FuncDecl{rawCtx: c.raw, rawFuncDecl: rawDecl},
+				Recognizer: &FuncDecl{rawCtx: c.raw, rawFuncDecl: rawRecognizer},
+				Accessors:  make([]*FuncDecl, len(rawAccessors)),
+			}
+			for k, raw := range rawAccessors {
+				constructor.Accessors[k] = &FuncDecl{rawCtx: c.raw, rawFuncDecl: raw}
+			}
+
+			d.Constructors[j] = constructor
+		}
+
+		result[i] = d
+	}
+
+	return result
+}
+
+// newRawConstructor creates the raw Z3 constructor for a spec. The
+// result must be freed with Z3_del_constructor.
+func (c *Context) newRawConstructor(spec ConstructorSpec) C.Z3_constructor {
+	recognizer := spec.Recognizer
+	if recognizer == "" {
+		recognizer = "is-" + spec.Name
+	}
+
+	n := len(spec.Fields)
+	rawNames := make([]C.Z3_symbol, n)
+	rawSorts := make([]C.Z3_sort, n)
+	rawRefs := make([]C.uint, n)
+	for i, f := range spec.Fields {
+		rawNames[i] = c.Symbol(f.Name).rawSymbol
+		if f.Sort != nil {
+			rawSorts[i] = f.Sort.rawSort
+		}
+		rawRefs[i] = C.uint(f.SortRef)
+	}
+
+	var rawNamesPtr *C.Z3_symbol
+	var rawSortsPtr *C.Z3_sort
+	var rawRefsPtr *C.uint
+	if n > 0 {
+		rawNamesPtr = (*C.Z3_symbol)(unsafe.Pointer(&rawNames[0]))
+		rawSortsPtr = (*C.Z3_sort)(unsafe.Pointer(&rawSorts[0]))
+		rawRefsPtr = (*C.uint)(unsafe.Pointer(&rawRefs[0]))
+	}
+
+	return C.Z3_mk_constructor(
+		c.raw,
+		c.Symbol(spec.Name).rawSymbol,
+		c.Symbol(recognizer).rawSymbol,
+		C.uint(n),
+		rawNamesPtr,
+		rawSortsPtr,
+		rawRefsPtr)
+}
+
+// Constructor returns the constructor with the given name, or nil if
+// there is none.
+func (d *Datatype) Constructor(name string) *Constructor {
+	for _, c := range d.Constructors {
+		if c.Name == name {
+			return c
+		}
+	}
+
+	return nil
+}
+
+// Decode returns the constructor used to build the value v along with
+// the values of its fields. v should be a value of this datatype, such
+// as the result of Model.Eval. This will return nil if v isn't built
+// directly with one of the constructors.
+//
+// This doesn't map to any specific Z3 API. This is a higher-level function
+// provided by go-z3 to make the Z3 API easier to consume in Go.
+func (d *Datatype) Decode(v *AST) (*Constructor, []*AST) {
+	if C.Z3_get_ast_kind(v.rawCtx, v.rawAST) != C.Z3_APP_AST {
+		return nil, nil
+	}
+
+	app := C.Z3_to_app(v.rawCtx, v.rawAST)
+	decl := C.Z3_get_app_decl(v.rawCtx, app)
+	for _, c := range d.Constructors {
+		if !bool(C.Z3_is_eq_func_decl(v.rawCtx, decl, c.Decl.rawFuncDecl)) {
+			continue
+		}
+
+		n := C.Z3_get_app_num_args(v.rawCtx, app)
+		fields := make([]*AST, n)
+		for i := C.uint(0); i < n; i++ {
+			fields[i] = &AST{
+				rawCtx: v.rawCtx,
+				rawAST: C.Z3_get_app_arg(v.rawCtx, app, i),
+			}
+		}
+
+		return c, fields
+	}
+
+	return nil, nil
+}
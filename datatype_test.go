@@ -0,0 +1,186 @@
+package z3
+
+import (
+	"testing"
+)
+
+func TestEnumSort(t *testing.T) {
+	config := NewConfig()
+	defer config.Close()
+	ctx := NewContext(config)
+	defer ctx.Close()
+
+	color := ctx.EnumSort("Color", "red", "green", "blue")
+	if v := color.Sort.String(); v != "Color" {
+		t.Fatalf("bad: %s", v)
+	}
+
+	// Create the solver
+	s := ctx.NewSolver()
+	defer s.Close()
+
+	// x is neither red nor green
+	x := ctx.Const(ctx.Symbol("x"), color.Sort)
+	s.Assert(x.Eq(color.Constructor("red").Decl.Apply()).Not())
+	s.Assert(color.Constructor("green").Recognizer.Apply(x).Not())
+
+	if v := s.Check(); v != True {
+		t.Fatalf("bad: %v", v)
+	}
+
+	m := s.Model()
+	defer m.Close()
+
+	c, fields := color.Decode(m.Eval(x))
+	if c == nil || c.Name != "blue" || len(fields) != 0 {
+		t.Fatalf("bad: %v %v", c, fields)
+	}
+}
+
+func TestTupleSort(t *testing.T) {
+	config := NewConfig()
+	defer config.Close()
+	ctx := NewContext(config)
+	defer ctx.Close()
+
+	pair := ctx.TupleSort("Pair",
+		FieldSpec{Name: "first", Sort: ctx.IntSort()},
+		FieldSpec{Name: "second", Sort: ctx.BoolSort()})
+	mk := pair.Constructors[0]
+
+	raw := mk.Decl.Apply(ctx.Int(1, ctx.IntSort()), ctx.True())
+	if v := raw.String(); v != "(Pair 1 true)" {
+		t.Fatalf("bad: %s", v)
+	}
+
+	// Create the solver
+	s := ctx.NewSolver()
+	defer s.Close()
+
+	// first(p) = 42 and second(p)
+	p := ctx.Const(ctx.Symbol("p"), pair.Sort)
+	s.Assert(mk.Accessors[0].Apply(p).Eq(ctx.Int(42, ctx.IntSort())))
+	s.Assert(mk.Accessors[1].Apply(p))
+
+	if v := s.Check(); v != True {
+		t.Fatalf("bad: %v", v)
+	}
+
+	m := s.Model()
+	defer m.Close()
+
+	c, fields := pair.Decode(m.Eval(p))
+	if c != mk || len(fields) != 2 {
+		t.Fatalf("bad: %v %v", c, fields)
+	}
+	if v := fields[0].Int(); v != 42 {
+		t.Fatalf("bad: %d", v)
+	}
+	if v := fields[1].String(); v != "true" {
+		t.Fatalf("bad: %s", v)
+	}
+}
+
+func TestDatatype_recursive(t *testing.T) {
+	config := NewConfig()
+	defer config.Close()
+	ctx := NewContext(config)
+	defer ctx.Close()
+
+	// List = nil | cons(head Int, tail List)
+	list := ctx.Datatype("List",
+		ConstructorSpec{Name: "nil"},
+		ConstructorSpec{
+			Name: "cons",
+			Fields: []FieldSpec{
+				{Name: "head", Sort: ctx.IntSort()},
+				{Name: "tail", SortRef: 0},
+			},
+		})
+	nilC := list.Constructor("nil")
+	cons := list.Constructor("cons")
+	if v := cons.Decl.Domain(1).String(); v != "List" {
+		t.Fatalf("bad: %s", v)
+	}
+
+	// Create the solver
+	s := ctx.NewSolver()
+	defer s.Close()
+
+	// l is a cons whose tail is empty and whose head is 7
+	l := ctx.Const(ctx.Symbol("l"), list.Sort)
+	s.Assert(cons.Recognizer.Apply(l))
+	s.Assert(cons.Accessors[0].Apply(l).Eq(ctx.Int(7, ctx.IntSort())))
+	s.Assert(cons.Accessors[1].Apply(l).Eq(nilC.Decl.Apply()))
+
+	if v := s.Check(); v != True {
+		t.Fatalf("bad: %v", v)
+	}
+
+	m := s.Model()
+	defer m.Close()
+
+	c, fields := list.Decode(m.Eval(l))
+	if c != cons || fields[0].Int() != 7 {
+		t.Fatalf("bad: %v %v", c, fields)
+	}
+	if c, _ := list.Decode(fields[1]); c != nilC {
+		t.Fatalf("bad: %v", c)
+	}
+}
+
+func TestDatatypes_mutual(t *testing.T) {
+	config := NewConfig()
+	defer config.Close()
+	ctx := NewContext(config)
+	defer ctx.Close()
+
+	// Tree = leaf(value Int) | node(children Forest)
+	// Forest = empty | branch(first Tree, rest Forest)
+	ds := ctx.Datatypes(
+		DatatypeSpec{
+			Name: "Tree",
+			Constructors: []ConstructorSpec{
+				{Name: "leaf", Fields: []FieldSpec{{Name: "value", Sort: ctx.IntSort()}}},
+				{Name: "node", Fields: []FieldSpec{{Name: "children", SortRef: 1}}},
+			},
+		},
+		DatatypeSpec{
+			Name: "Forest",
+			Constructors: []ConstructorSpec{
+				{Name: "empty"},
+				{Name: "branch", Fields: []FieldSpec{
+					{Name: "first", SortRef: 0},
+					{Name: "rest", SortRef: 1},
+				}},
+			},
+		})
+	tree, forest := ds[0], ds[1]
+
+	if v := tree.Constructor("node").Accessors[0].Range().String(); v != "Forest" {
+		t.Fatalf("bad: %s", v)
+	}
+	if v := forest.Constructor("branch").Accessors[0].Range().String(); v != "Tree" {
+		t.Fatalf("bad: %s", v)
+	}
+
+	// node(branch(leaf(1), empty))
+	raw := tree.Constructor("node").Decl.Apply(
+		forest.Constructor("branch").Decl.Apply(
+			tree.Constructor("leaf").Decl.Apply(ctx.Int(1, ctx.IntSort())),
+			forest.Constructor("empty").Decl.Apply()))
+	if v := raw.String(); v != "(node (branch (leaf 1) empty))" {
+		t.Fatalf("bad: %s", v)
+	}
+}
+
+func TestDatatypes_empty(t *testing.T) {
+	config := NewConfig()
+	defer config.Close()
+	ctx := NewContext(config)
+	defer ctx.Close()
+
+	if v := ctx.Datatypes(); v != nil {
+		t.Fatalf("bad: %v", v)
+	}
+}
@@ -11,3 +11,326 @@ const (
 	Undef       = C.Z3_L_UNDEF
 	True        = C.Z3_L_TRUE
 )
+
+// ASTKind is the kind of an AST node.
+type ASTKind uint
+
+const (
+	ASTNumeral    ASTKind = C.Z3_NUMERAL_AST
+	ASTApp                = C.Z3_APP_AST
+	ASTVar                = C.Z3_VAR_AST
+	ASTQuantifier         = C.Z3_QUANTIFIER_AST
+	ASTSort               = C.Z3_SORT_AST
+	ASTFuncDecl           = C.Z3_FUNC_DECL_AST
+	ASTUnknown            = C.Z3_UNKNOWN_AST
+)
+
+// String returns a human-friendly name for the kind.
+func (k ASTKind) String() string {
+	switch k {
+	case ASTNumeral:
+		return "numeral"
+	case ASTApp:
+		return "app"
+	case ASTVar:
+		return "var"
+	case ASTQuantifier:
+		return "quantifier"
+	case ASTSort:
+		return "sort"
+	case ASTFuncDecl:
+		return "func decl"
+	default:
+		return "unknown"
+	}
+}
+
+// DeclKind is the kind of a function declaration, identifying the
+// built-in operator it represents. Declarations created with
+// Context.FuncDecl are OpUninterpreted.
+type DeclKind uint
+
+const (
+	// Basic
+	OpTrue     DeclKind = C.Z3_OP_TRUE
+	OpFalse             = C.Z3_OP_FALSE
+	OpEq                = C.Z3_OP_EQ
+	OpDistinct          = C.Z3_OP_DISTINCT
+	OpIte               = C.Z3_OP_ITE
+	OpAnd               = C.Z3_OP_AND
+	OpOr                = C.Z3_OP_OR
+	OpIff               = C.Z3_OP_IFF
+	OpXor               = C.Z3_OP_XOR
+	OpNot               = C.Z3_OP_NOT
+	OpImplies           = C.Z3_OP_IMPLIES
+	OpOEq               = C.Z3_OP_OEQ
+
+	// Arithmetic
+	OpANum   = C.Z3_OP_ANUM
+	OpAGNum  = C.Z3_OP_AGNUM
+	OpLe     = C.Z3_OP_LE
+	OpGe     = C.Z3_OP_GE
+	OpLt     = C.Z3_OP_LT
+	OpGt     = C.Z3_OP_GT
+	OpAdd    = C.Z3_OP_ADD
+	OpSub    = C.Z3_OP_SUB
+	OpUMinus = C.Z3_OP_UMINUS
+	OpMul    = C.Z3_OP_MUL
+	OpDiv    = C.Z3_OP_DIV
+	OpIDiv   = C.Z3_OP_IDIV
+	OpRem    = C.Z3_OP_REM
+	OpMod    = C.Z3_OP_MOD
+	OpToReal = C.Z3_OP_TO_REAL
+	OpToInt  = C.Z3_OP_TO_INT
+	OpIsInt  = C.Z3_OP_IS_INT
+	OpPower  = C.Z3_OP_POWER
+
+	// Arrays and sets
+	OpStore         = C.Z3_OP_STORE
+	OpSelect        = C.Z3_OP_SELECT
+	OpConstArray    = C.Z3_OP_CONST_ARRAY
+	OpArrayMap      = C.Z3_OP_ARRAY_MAP
+	OpArrayDefault  = C.Z3_OP_ARRAY_DEFAULT
+	OpSetUnion      = C.Z3_OP_SET_UNION
+	OpSetIntersect  = C.Z3_OP_SET_INTERSECT
+	OpSetDifference = C.Z3_OP_SET_DIFFERENCE
+	OpSetComplement = C.Z3_OP_SET_COMPLEMENT
+	OpSetSubset     = C.Z3_OP_SET_SUBSET
+	OpAsArray       = C.Z3_OP_AS_ARRAY
+	OpArrayExt      = C.Z3_OP_ARRAY_EXT
+	OpSetHasSize    = C.Z3_OP_SET_HAS_SIZE
+	OpSetCard       = C.Z3_OP_SET_CARD
+
+	// Bit-vectors
+	OpBNum           = C.Z3_OP_BNUM
+	OpBit1           = C.Z3_OP_BIT1
+	OpBit0           = C.Z3_OP_BIT0
+	OpBNeg           = C.Z3_OP_BNEG
+	OpBAdd           = C.Z3_OP_BADD
+	OpBSub           = C.Z3_OP_BSUB
+	OpBMul           = C.Z3_OP_BMUL
+	OpBSDiv          = C.Z3_OP_BSDIV
+	OpBUDiv          = C.Z3_OP_BUDIV
+	OpBSRem          = C.Z3_OP_BSREM
+	OpBURem          = C.Z3_OP_BUREM
+	OpBSMod          = C.Z3_OP_BSMOD
+	OpBSDiv0         = C.Z3_OP_BSDIV0
+	OpBUDiv0         = C.Z3_OP_BUDIV0
+	OpBSRem0         = C.Z3_OP_BSREM0
+	OpBURem0         = C.Z3_OP_BUREM0
+	OpBSMod0         = C.Z3_OP_BSMOD0
+	OpULeq           = C.Z3_OP_ULEQ
+	OpSLeq           = C.Z3_OP_SLEQ
+	OpUGeq           = C.Z3_OP_UGEQ
+	OpSGeq           = C.Z3_OP_SGEQ
+	OpULt            = C.Z3_OP_ULT
+	OpSLt            = C.Z3_OP_SLT
+	OpUGt            = C.Z3_OP_UGT
+	OpSGt            = C.Z3_OP_SGT
+	OpBAnd           = C.Z3_OP_BAND
+	OpBOr            = C.Z3_OP_BOR
+	OpBNot           = C.Z3_OP_BNOT
+	OpBXor           = C.Z3_OP_BXOR
+	OpBNand          = C.Z3_OP_BNAND
+	OpBNor           = C.Z3_OP_BNOR
+	OpBXnor          = C.Z3_OP_BXNOR
+	OpConcat         = C.Z3_OP_CONCAT
+	OpSignExt        = C.Z3_OP_SIGN_EXT
+	OpZeroExt        = C.Z3_OP_ZERO_EXT
+	OpExtract        = C.Z3_OP_EXTRACT
+	OpRepeat         = C.Z3_OP_REPEAT
+	OpBRedOr         = C.Z3_OP_BREDOR
+	OpBRedAnd        = C.Z3_OP_BREDAND
+	OpBComp          = C.Z3_OP_BCOMP
+	OpBShl           = C.Z3_OP_BSHL
+	OpBLShr          = C.Z3_OP_BLSHR
+	OpBAShr          = C.Z3_OP_BASHR
+	OpRotateLeft     = C.Z3_OP_ROTATE_LEFT
+	OpRotateRight    = C.Z3_OP_ROTATE_RIGHT
+	OpExtRotateLeft  = C.Z3_OP_EXT_ROTATE_LEFT
+	OpExtRotateRight = C.Z3_OP_EXT_ROTATE_RIGHT
+	OpBit2Bool       = C.Z3_OP_BIT2BOOL
+	OpInt2BV         = C.Z3_OP_INT2BV
+	OpBV2Int         = C.Z3_OP_BV2INT
+	OpCarry          = C.Z3_OP_CARRY
+	OpXor3           = C.Z3_OP_XOR3
+	OpBSMulNoOvfl    = C.Z3_OP_BSMUL_NO_OVFL
+	OpBUMulNoOvfl    = C.Z3_OP_BUMUL_NO_OVFL
+	OpBSMulNoUdfl    = C.Z3_OP_BSMUL_NO_UDFL
+	OpBSDivI         = C.Z3_OP_BSDIV_I
+	OpBUDivI         = C.Z3_OP_BUDIV_I
+	OpBSRemI         = C.Z3_OP_BSREM_I
+	OpBURemI         = C.Z3_OP_BUREM_I
+	OpBSModI         = C.Z3_OP_BSMOD_I
+
+	// Proofs
+	OpPrUndef            = C.Z3_OP_PR_UNDEF
+	OpPrTrue             = C.Z3_OP_PR_TRUE
+	OpPrAsserted         = C.Z3_OP_PR_ASSERTED
+	OpPrGoal             = C.Z3_OP_PR_GOAL
+	OpPrModusPonens      = C.Z3_OP_PR_MODUS_PONENS
+	OpPrReflexivity      = C.Z3_OP_PR_REFLEXIVITY
+	OpPrSymmetry         = C.Z3_OP_PR_SYMMETRY
+	OpPrTransitivity     = C.Z3_OP_PR_TRANSITIVITY
+	OpPrTransitivityStar = C.Z3_OP_PR_TRANSITIVITY_STAR
+	OpPrMonotonicity     = C.Z3_OP_PR_MONOTONICITY
+	OpPrQuantIntro       = C.Z3_OP_PR_QUANT_INTRO
+	OpPrBind             = C.Z3_OP_PR_BIND
+	OpPrDistributivity   = C.Z3_OP_PR_DISTRIBUTIVITY
+	OpPrAndElim          = C.Z3_OP_PR_AND_ELIM
+	OpPrNotOrElim        = C.Z3_OP_PR_NOT_OR_ELIM
+	OpPrRewrite          = C.Z3_OP_PR_REWRITE
+	OpPrRewriteStar      = C.Z3_OP_PR_REWRITE_STAR
+	OpPrPullQuant        = C.Z3_OP_PR_PULL_QUANT
+	OpPrPushQuant        = C.Z3_OP_PR_PUSH_QUANT
+	OpPrElimUnusedVars   = C.Z3_OP_PR_ELIM_UNUSED_VARS
+	OpPrDer              = C.Z3_OP_PR_DER
+	OpPrQuantInst        = C.Z3_OP_PR_QUANT_INST
+	OpPrHypothesis       = C.Z3_OP_PR_HYPOTHESIS
+	OpPrLemma            = C.Z3_OP_PR_LEMMA
+	OpPrUnitResolution   = C.Z3_OP_PR_UNIT_RESOLUTION
+	OpPrIffTrue          = C.Z3_OP_PR_IFF_TRUE
+	OpPrIffFalse         = C.Z3_OP_PR_IFF_FALSE
+	OpPrCommutativity    = C.Z3_OP_PR_COMMUTATIVITY
+	OpPrDefAxiom         = C.Z3_OP_PR_DEF_AXIOM
+	OpPrAssumptionAdd    = C.Z3_OP_PR_ASSUMPTION_ADD
+	OpPrLemmaAdd         = C.Z3_OP_PR_LEMMA_ADD
+	OpPrRedundantDel     = C.Z3_OP_PR_REDUNDANT_DEL
+	OpPrClauseTrail      = C.Z3_OP_PR_CLAUSE_TRAIL
+	OpPrDefIntro         = C.Z3_OP_PR_DEF_INTRO
+	OpPrApplyDef         = C.Z3_OP_PR_APPLY_DEF
+	OpPrIffOEq           = C.Z3_OP_PR_IFF_OEQ
+	OpPrNnfPos           = C.Z3_OP_PR_NNF_POS
+	OpPrNnfNeg           = C.Z3_OP_PR_NNF_NEG
+	OpPrSkolemize        = C.Z3_OP_PR_SKOLEMIZE
+	OpPrModusPonensOEq   = C.Z3_OP_PR_MODUS_PONENS_OEQ
+	OpPrThLemma          = C.Z3_OP_PR_TH_LEMMA
+	OpPrHyperResolve     = C.Z3_OP_PR_HYPER_RESOLVE
+
+	// Relational algebra
+	OpRAStore          = C.Z3_OP_RA_STORE
+	OpRAEmpty          = C.Z3_OP_RA_EMPTY
+	OpRAIsEmpty        = C.Z3_OP_RA_IS_EMPTY
+	OpRAJoin           = C.Z3_OP_RA_JOIN
+	OpRAUnion          = C.Z3_OP_RA_UNION
+	OpRAWiden          = C.Z3_OP_RA_WIDEN
+	OpRAProject        = C.Z3_OP_RA_PROJECT
+	OpRAFilter         = C.Z3_OP_RA_FILTER
+	OpRANegationFilter = C.Z3_OP_RA_NEGATION_FILTER
+	OpRARename         = C.Z3_OP_RA_RENAME
+	OpRAComplement     = C.Z3_OP_RA_COMPLEMENT
+	OpRASelect         = C.Z3_OP_RA_SELECT
+	OpRAClone          = C.Z3_OP_RA_CLONE
+
+	// Finite domains
+	OpFDConstant = C.Z3_OP_FD_CONSTANT
+	OpFDLt       = C.Z3_OP_FD_LT
+
+	// Sequences, strings and regular expressions
+	OpSeqUnit      = C.Z3_OP_SEQ_UNIT
+	OpSeqEmpty     = C.Z3_OP_SEQ_EMPTY
+	OpSeqConcat    = C.Z3_OP_SEQ_CONCAT
+	OpSeqPrefix    = C.Z3_OP_SEQ_PREFIX
+	OpSeqSuffix    = C.Z3_OP_SEQ_SUFFIX
+	OpSeqContains  = C.Z3_OP_SEQ_CONTAINS
+	OpSeqExtract   = C.Z3_OP_SEQ_EXTRACT
+	OpSeqReplace   = C.Z3_OP_SEQ_REPLACE
+	OpSeqAt        = C.Z3_OP_SEQ_AT
+	OpSeqNth       = C.Z3_OP_SEQ_NTH
+	OpSeqLength    = C.Z3_OP_SEQ_LENGTH
+	OpSeqIndex     = C.Z3_OP_SEQ_INDEX
+	OpSeqLastIndex = C.Z3_OP_SEQ_LAST_INDEX
+	OpSeqToRe      = C.Z3_OP_SEQ_TO_RE
+	OpSeqInRe      = C.Z3_OP_SEQ_IN_RE
+	OpStrToInt     = C.Z3_OP_STR_TO_INT
+	OpIntToStr     = C.Z3_OP_INT_TO_STR
+	OpStringLt     = C.Z3_OP_STRING_LT
+	OpStringLe     = C.Z3_OP_STRING_LE
+	OpRePlus       = C.Z3_OP_RE_PLUS
+	OpReStar       = C.Z3_OP_RE_STAR
+	OpReOption     = C.Z3_OP_RE_OPTION
+	OpReConcat     = C.Z3_OP_RE_CONCAT
+	OpReUnion      = C.Z3_OP_RE_UNION
+	OpReRange      = C.Z3_OP_RE_RANGE
+	OpReLoop       = C.Z3_OP_RE_LOOP
+	OpReIntersect  = C.Z3_OP_RE_INTERSECT
+	OpReEmptySet   = C.Z3_OP_RE_EMPTY_SET
+	OpReFullSet    = C.Z3_OP_RE_FULL_SET
+	OpReComplement = C.Z3_OP_RE_COMPLEMENT
+
+	// Labels
+	OpLabel    = C.Z3_OP_LABEL
+	OpLabelLit = C.Z3_OP_LABEL_LIT
+
+	// Datatypes
+	OpDTConstructor = C.Z3_OP_DT_CONSTRUCTOR
+	OpDTRecogniser  = C.Z3_OP_DT_RECOGNISER
+	OpDTIs          = C.Z3_OP_DT_IS
+	OpDTAccessor    = C.Z3_OP_DT_ACCESSOR
+	OpDTUpdateField = C.Z3_OP_DT_UPDATE_FIELD
+
+	// Pseudo-Booleans
+	OpPBAtMost  = C.Z3_OP_PB_AT_MOST
+	OpPBAtLeast = C.Z3_OP_PB_AT_LEAST
+	OpPBLe      = C.Z3_OP_PB_LE
+	OpPBGe      = C.Z3_OP_PB_GE
+	OpPBEq      = C.Z3_OP_PB_EQ
+
+	// Special relations
+	OpSpecialRelationLO  = C.Z3_OP_SPECIAL_RELATION_LO
+	OpSpecialRelationPO  = C.Z3_OP_SPECIAL_RELATION_PO
+	OpSpecialRelationPLO = C.Z3_OP_SPECIAL_RELATION_PLO
+	OpSpecialRelationTO  = C.Z3_OP_SPECIAL_RELATION_TO
+	OpSpecialRelationTC  = C.Z3_OP_SPECIAL_RELATION_TC
+	OpSpecialRelationTRC = C.Z3_OP_SPECIAL_RELATION_TRC
+
+	// Floating-point
+	OpFPARMNearestTiesToEven = C.Z3_OP_FPA_RM_NEAREST_TIES_TO_EVEN
+	OpFPARMNearestTiesToAway = C.Z3_OP_FPA_RM_NEAREST_TIES_TO_AWAY
+	OpFPARMTowardPositive    = C.Z3_OP_FPA_RM_TOWARD_POSITIVE
+	OpFPARMTowardNegative    = C.Z3_OP_FPA_RM_TOWARD_NEGATIVE
+	OpFPARMTowardZero        = C.Z3_OP_FPA_RM_TOWARD_ZERO
+	OpFPANum                 = C.Z3_OP_FPA_NUM
+	OpFPAPlusInf             = C.Z3_OP_FPA_PLUS_INF
+	OpFPAMinusInf            = C.Z3_OP_FPA_MINUS_INF
+	OpFPANaN                 = C.Z3_OP_FPA_NAN
+	OpFPAPlusZero            = C.Z3_OP_FPA_PLUS_ZERO
+	OpFPAMinusZero           = C.Z3_OP_FPA_MINUS_ZERO
+	OpFPAAdd                 = C.Z3_OP_FPA_ADD
+	OpFPASub                 = C.Z3_OP_FPA_SUB
+	OpFPANeg                 = C.Z3_OP_FPA_NEG
+	OpFPAMul                 = C.Z3_OP_FPA_MUL
+	OpFPADiv                 = C.Z3_OP_FPA_DIV
+	OpFPARem                 = C.Z3_OP_FPA_REM
+	OpFPAAbs                 = C.Z3_OP_FPA_ABS
+	OpFPAMin                 = C.Z3_OP_FPA_MIN
+	OpFPAMax                 = C.Z3_OP_FPA_MAX
+	OpFPAFMA                 = C.Z3_OP_FPA_FMA
+	OpFPASqrt                = C.Z3_OP_FPA_SQRT
+	OpFPARoundToIntegral     = C.Z3_OP_FPA_ROUND_TO_INTEGRAL
+	OpFPAEq                  = C.Z3_OP_FPA_EQ
+	OpFPALt                  = C.Z3_OP_FPA_LT
+	OpFPAGt                  = C.Z3_OP_FPA_GT
+	OpFPALe                  = C.Z3_OP_FPA_LE
+	OpFPAGe                  = C.Z3_OP_FPA_GE
+	OpFPAIsNaN               = C.Z3_OP_FPA_IS_NAN
+	OpFPAIsInf               = C.Z3_OP_FPA_IS_INF
+	OpFPAIsZero              = C.Z3_OP_FPA_IS_ZERO
+	OpFPAIsNormal            = C.Z3_OP_FPA_IS_NORMAL
+	OpFPAIsSubnormal         = C.Z3_OP_FPA_IS_SUBNORMAL
+	OpFPAIsNegative          = C.Z3_OP_FPA_IS_NEGATIVE
+	OpFPAIsPositive          = C.Z3_OP_FPA_IS_POSITIVE
+	OpFPAFP                  = C.Z3_OP_FPA_FP
+	OpFPAToFP                = C.Z3_OP_FPA_TO_FP
+	OpFPAToFPUnsigned        = C.Z3_OP_FPA_TO_FP_UNSIGNED
+	OpFPAToUBV               = C.Z3_OP_FPA_TO_UBV
+	OpFPAToSBV               = C.Z3_OP_FPA_TO_SBV
+	OpFPAToReal              = C.Z3_OP_FPA_TO_REAL
+	OpFPAToIEEEBV            = C.Z3_OP_FPA_TO_IEEE_BV
+	OpFPABVWrap              = C.Z3_OP_FPA_BVWRAP
+	OpFPABV2RM               = C.Z3_OP_FPA_BV2RM
+
+	// Other
+	OpInternal      = C.Z3_OP_INTERNAL
+	OpUninterpreted = C.Z3_OP_UNINTERPRETED
+)
@@ -24,6 +24,18 @@ const (
 	ErrorCodeException                 = C.Z3_EXCEPTION
 )
 
+// Error is a Go error representing an error that occurred within Z3.
+// These are collected by the Context and can be retrieved with Err.
+type Error struct {
+	Code    ErrorCode
+	Message string
+}
+
+// Error implements error.
+func (e *Error) Error() string {
+	return e.Message
+}
+
 // ErrorHandler is the callback that is invoked when an error occurs in
 // Z3 and is registered by SetErrorHandler.
 type ErrorHandler func(*Context, ErrorCode)
@@ -32,6 +44,11 @@ type ErrorHandler func(*Context, ErrorCode)
 var errorHandlerMap = map[C.Z3_context]ErrorHandler{}
 var errorHandlerMapLock sync.RWMutex
 
+// errorMap tracks the first error that occurred for a context since the
+// last call to Err.
+var errorMap = map[C.Z3_context]*Error{}
+var errorMapLock sync.Mutex
+
 // SetErrorHandler registers the error handler. This handler is invoked
 // whenever an error occurs within Z3.
 func (c *Context) SetErrorHandler(f ErrorHandler) {
@@ -42,6 +59,17 @@ func (c *Context) SetErrorHandler(f ErrorHandler) {
 	errorHandlerMap[c.raw] = f
 }
 
+// Err returns the first error that occurred within this context since
+// the last call to Err, or nil if no error occurred. Calling Err clears
+// the error.
+//
+// This is the alternative to SetErrorHandler for ordinary Go error
+// handling: build ASTs, assert them, and then check Err once. Errors are
+// always recorded, whether or not an error handler is set.
+func (c *Context) Err() error {
+	return takeError(c.raw)
+}
+
 // Error returns the error message for the given error code.
 // This code can be retrieved via the error handler callback.
 //
@@ -53,8 +81,63 @@ func (c *Context) Error(code ErrorCode) string {
 	return C.GoString(C.Z3_get_error_msg(c.raw, C.Z3_error_code(code)))
 }
 
+// takeError returns and clears the pending error for the raw context.
+func takeError(raw C.Z3_context) error {
+	errorMapLock.Lock()
+	defer errorMapLock.Unlock()
+
+	err, ok := errorMap[raw]
+	if !ok {
+		return nil
+	}
+
+	delete(errorMap, raw)
+	return err
+}
+
+// setError records err as the pending error for the raw context unless
+// an error is already pending. This is used for invalid arguments that
+// are caught in Go before reaching Z3, so the error handler isn't called.
+func setError(raw C.Z3_context, err *Error) {
+	errorMapLock.Lock()
+	defer errorMapLock.Unlock()
+
+	if _, ok := errorMap[raw]; !ok {
+		errorMap[raw] = err
+	}
+}
+
+// withError calls f and returns the error that occurred within it, if
+// any. This is used by functions that return an error. An error that
+// was already pending before f is kept for Err, so it isn't lost or
+// mistaken for an error of f.
+func withError(raw C.Z3_context, f func()) error {
+	pending := takeError(raw)
+	f()
+	err := takeError(raw)
+
+	if pending != nil {
+		errorMapLock.Lock()
+		errorMap[raw] = pending.(*Error)
+		errorMapLock.Unlock()
+	}
+
+	return err
+}
+
 //export goZ3ErrorHandler
 func goZ3ErrorHandler(raw C.Z3_context, code C.Z3_error_code) {
+	// Record the error. The message must be read now since the error
+	// state on the context may be cleared by the next Z3 call.
+	errorMapLock.Lock()
+	if _, ok := errorMap[raw]; !ok {
+		errorMap[raw] = &Error{
+			Code:    ErrorCode(code),
+			Message: C.GoString(C.Z3_get_error_msg(raw, code)),
+		}
+	}
+	errorMapLock.Unlock()
+
 	errorHandlerMapLock.RLock()
 	defer errorHandlerMapLock.RUnlock()
 
@@ -32,3 +32,75 @@ func TestContextErrorHandler(t *testing.T) {
 		t.Fatalf("bad: %s", msg)
 	}
 }
+
+func TestContextErr(t *testing.T) {
+	config := NewConfig()
+	defer config.Close()
+	ctx := NewContext(config)
+	defer ctx.Close()
+
+	// No error yet
+	if err := ctx.Err(); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	// Create an int
+	x := ctx.Const(ctx.Symbol("x"), ctx.BoolSort())
+	y := ctx.Const(ctx.Symbol("y"), ctx.BoolSort())
+
+	// This won't work because x and y aren't ints
+	x.Ge(y)
+	err := ctx.Err()
+	if err == nil {
+		t.Fatal("should error")
+	}
+	zerr, ok := err.(*Error)
+	if !ok {
+		t.Fatalf("bad: %#v", err)
+	}
+	if zerr.Code == ErrorCodeOk {
+		t.Fatalf("bad: %d", zerr.Code)
+	}
+	if !strings.Contains(zerr.Message, "Sort mismatch") {
+		t.Fatalf("bad: %s", zerr.Message)
+	}
+
+	// The error is cleared once read
+	if err := ctx.Err(); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
+
+func TestContextErrKeptAcrossErrorReturns(t *testing.T) {
+	config := NewConfig()
+	defer config.Close()
+	ctx := NewContext(config)
+	defer ctx.Close()
+
+	// Record a sort error
+	x := ctx.Const(ctx.Symbol("x"), ctx.BoolSort())
+	x.Ge(x)
+
+	// Functions returning an error neither lose the pending error nor
+	// report it as their own
+	tac, err := ctx.Tactic("simplify")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer tac.Close()
+
+	if _, err := ctx.ParseSMTLIB2String("(assert", nil, nil); err == nil {
+		t.Fatal("should error")
+	} else if strings.Contains(err.Error(), "Sort mismatch") {
+		t.Fatalf("bad: %s", err)
+	}
+
+	// The first error is still pending
+	err = ctx.Err()
+	if err == nil || !strings.Contains(err.Error(), "Sort mismatch") {
+		t.Fatalf("bad: %v", err)
+	}
+	if err := ctx.Err(); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
@@ -0,0 +1,116 @@
+package z3
+
+import (
+	"unsafe"
+)
+
+// #include "go-z3.h"
+import "C"
+
+// FuncDecl represents a function declaration in Z3. Function declarations
+// are used for uninterpreted functions, and Z3 also uses them to describe
+// constants (functions with no arguments) and the operators that make up
+// an AST.
+//
+// FuncDecl memory management is automatically managed by the Context it
+// is contained within. When the Context is freed, so are the FuncDecls.
+type FuncDecl struct {
+	rawCtx      C.Z3_context
+	rawFuncDecl C.Z3_func_decl
+}
+
+// FuncDecl declares an uninterpreted function with the given name,
+// argument types and return type.
+//
+// Maps: Z3_mk_func_decl
+func (c *Context) FuncDecl(name *Symbol, domain []*Sort, rng *Sort) *FuncDecl {
+	raws := make([]C.Z3_sort, len(domain))
+	for i, s := range domain {
+		raws[i] = s.rawSort
+	}
+
+	var rawDomain *C.Z3_sort
+	if len(raws) > 0 {
+		rawDomain = (*C.Z3_sort)(unsafe.Pointer(&raws[0]))
+	}
+
+	return &FuncDecl{
+		rawCtx: c.raw,
+		rawFuncDecl: C.Z3_mk_func_decl(
+			c.raw, name.rawSymbol, C.uint(len(raws)), rawDomain, rng.rawSort),
+	}
+}
+
+// String returns a human-friendly string version of the declaration.
+func (f *FuncDecl) String() string {
+	return C.GoString(C.Z3_func_decl_to_string(f.rawCtx, f.rawFuncDecl))
+}
+
+// Name returns the name of the declaration.
+//
+// Maps: Z3_get_decl_name
+func (f *FuncDecl) Name() *Symbol {
+	return &Symbol{
+		rawCtx:    f.rawCtx,
+		rawSymbol: C.Z3_get_decl_name(f.rawCtx, f.rawFuncDecl),
+	}
+}
+
+// Arity returns the number of arguments of the declaration.
+//
+// Maps: Z3_get_arity
+func (f *FuncDecl) Arity() uint {
+	return uint(C.Z3_get_arity(f.rawCtx, f.rawFuncDecl))
+}
+
+// Domain returns the type of the argument at index i. i must be less
+// than Arity.
+//
+// Maps: Z3_get_domain
+func (f *FuncDecl) Domain(i uint) *Sort {
+	return &Sort{
+		rawCtx:  f.rawCtx,
+		rawSort: C.Z3_get_domain(f.rawCtx, f.rawFuncDecl, C.uint(i)),
+	}
+}
+
+// Range returns the return type of the declaration.
+//
+// Maps: Z3_get_range
+func (f *FuncDecl) Range() *Sort {
+	return &Sort{
+		rawCtx:  f.rawCtx,
+		rawSort: C.Z3_get_range(f.rawCtx, f.rawFuncDecl),
+	}
+}
+
+// Kind returns the kind of the declaration, identifying built-in
+// operators such as OpAdd or OpBAdd.
+//
+// Maps: Z3_get_decl_kind
+func (f *FuncDecl) Kind() DeclKind {
+	return DeclKind(C.Z3_get_decl_kind(f.rawCtx, f.rawFuncDecl))
+}
+
+// Apply creates an AST node representing the application of the
+// function to the given arguments. The number and types of the arguments
+// must match the declaration.
+//
+// Maps: Z3_mk_app
+func (f *FuncDecl) Apply(args ...*AST) *AST {
+	raws := make([]C.Z3_ast, len(args))
+	for i, arg := range args {
+		raws[i] = arg.rawAST
+	}
+
+	var rawArgs *C.Z3_ast
+	if len(raws) > 0 {
+		rawArgs = (*C.Z3_ast)(unsafe.Pointer(&raws[0]))
+	}
+
+	return &AST{
+		rawCtx: f.rawCtx,
+		rawAST: C.Z3_mk_app(
+			f.rawCtx, f.rawFuncDecl, C.uint(len(raws)), rawArgs),
+	}
+}
@@ -0,0 +1,58 @@
+package z3
+
+import (
+	"testing"
+)
+
+func TestFuncDecl(t *testing.T) {
+	config := NewConfig()
+	defer config.Close()
+	ctx := NewContext(config)
+	defer ctx.Close()
+
+	// f : Int x Bool -> Int
+	f := ctx.FuncDecl(
+		ctx.Symbol("f"),
+		[]*Sort{ctx.IntSort(), ctx.BoolSort()},
+		ctx.IntSort())
+
+	if v := f.Name().String(); v != "f" {
+		t.Fatalf("bad: %s", v)
+	}
+	if v := f.Arity(); v != 2 {
+		t.Fatalf("bad: %d", v)
+	}
+	if v := f.Domain(1).String(); v != "Bool" {
+		t.Fatalf("bad: %s", v)
+	}
+	if v := f.Range().String(); v != "Int" {
+		t.Fatalf("bad: %s", v)
+	}
+
+	// Apply it
+	x := ctx.Const(ctx.Symbol("x"), ctx.IntSort())
+	raw := f.Apply(x, ctx.True())
+
+	actual := raw.String()
+	if actual != "(f x true)" {
+		t.Fatalf("bad:\n%s", actual)
+	}
+}
+
+func TestFuncDeclArrayMap(t *testing.T) {
+	config := NewConfig()
+	defer config.Close()
+	ctx := NewContext(config)
+	defer ctx.Close()
+
+	// f : Int -> Bool
+	f := ctx.FuncDecl(ctx.Symbol("f"), []*Sort{ctx.IntSort()}, ctx.BoolSort())
+	a := ctx.Const(ctx.Symbol("a"), ctx.ArraySort(ctx.IntSort(), ctx.IntSort()))
+
+	raw := ctx.ArrayMap(f, a)
+
+	actual := raw.String()
+	if actual != "((_ map f) a)" {
+		t.Fatalf("bad:\n%s", actual)
+	}
+}
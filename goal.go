@@ -0,0 +1,186 @@
+package z3
+
+// #include "go-z3.h"
+import "C"
+
+// Goal is a set of formulas that tactics can be applied to. Goals are
+// the input and the output of tactics: applying a tactic to a goal
+// results in zero or more subgoals.
+//
+// It is created via NewGoal on Context. When the goal is no longer
+// needed, the Close method must be called.
+type Goal struct {
+	rawCtx  C.Z3_context
+	rawGoal C.Z3_goal
+}
+
+// GoalPrecision describes how the formulas of a goal relate to the
+// formulas of the goal it was derived from.
+type GoalPrecision uint
+
+const (
+	// GoalPrecise goals are equisatisfiable with the original goal.
+	GoalPrecise GoalPrecision = C.Z3_GOAL_PRECISE
+
+	// GoalUnder goals are under-approximations: if the goal is
+	// satisfiable, so is the original goal.
+	GoalUnder = C.Z3_GOAL_UNDER
+
+	// GoalOver goals are over-approximations: if the goal is
+	// unsatisfiable, so is the original goal.
+	GoalOver = C.Z3_GOAL_OVER
+
+	// GoalUnderOver goals are both under- and over-approximated, so
+	// neither result carries over to the original goal.
+	GoalUnderOver = C.Z3_GOAL_UNDER_OVER
+)
+
+// NewGoal creates a new, empty goal. models, unsatCores and proofs
+// enable tracking of the respective information while tactics are
+// applied. Proofs can only be enabled if they are enabled in the Config
+// of the Context.
+//
+// Maps: Z3_mk_goal
+func (c *Context) NewGoal(models, unsatCores, proofs bool) *Goal {
+	return newGoal(c.raw, C.Z3_mk_goal(
+		c.raw, C.bool(models), C.bool(unsatCores), C.bool(proofs)))
+}
+
+// Close frees the memory associated with this.
+func (g *Goal) Close() error {
+	C.Z3_goal_dec_ref(g.rawCtx, g.rawGoal)
+	return nil
+}
+
+// String returns a human-friendly string version of the goal.
+func (g *Goal) String() string {
+	return C.GoString(C.Z3_goal_to_string(g.rawCtx, g.rawGoal))
+}
+
+// Assert adds a formula to the goal.
+//
+// Maps to: Z3_goal_assert
+func (g *Goal) Assert(a *AST) {
+	C.Z3_goal_assert(g.rawCtx, g.rawGoal, a.rawAST)
+}
+
+// Size returns the number of formulas in the goal.
+//
+// Maps to: Z3_goal_size
+func (g *Goal) Size() uint {
+	return uint(C.Z3_goal_size(g.rawCtx, g.rawGoal))
+}
+
+// Formula returns the formula at the given index, which must be less
+// than Size.
+//
+// Maps to: Z3_goal_formula
+func (g *Goal) Formula(idx uint) *AST {
+	return &AST{
+		rawCtx: g.rawCtx,
+		rawAST: C.Z3_goal_formula(g.rawCtx, g.rawGoal, C.uint(idx)),
+	}
+}
+
+// Formulas returns all the formulas in the goal.
+//
+// This doesn't map to any specific Z3 API. It calls Formula for every
+// index up to Size.
+func (g *Goal) Formulas() []*AST {
+	result := make([]*AST, g.Size())
+	for i := range result {
+		result[i] = g.Formula(uint(i))
+	}
+
+	return result
+}
+
+// NumExprs returns the total number of expressions in the goal, counting
+// every subterm of every formula.
+//
+// Maps to: Z3_goal_num_exprs
+func (g *Goal) NumExprs() uint {
+	return uint(C.Z3_goal_num_exprs(g.rawCtx, g.rawGoal))
+}
+
+// Precision returns how the goal relates to the goal it was derived
+// from.
+//
+// Maps to: Z3_goal_precision
+func (g *Goal) Precision() GoalPrecision {
+	return GoalPrecision(C.Z3_goal_precision(g.rawCtx, g.rawGoal))
+}
+
+// Depth returns the number of tactics that were applied to derive this
+// goal.
+//
+// Maps to: Z3_goal_depth
+func (g *Goal) Depth() uint {
+	return uint(C.Z3_goal_depth(g.rawCtx, g.rawGoal))
+}
+
+// Inconsistent returns true if the goal contains the formula false.
+//
+// Maps to: Z3_goal_inconsistent
+func (g *Goal) Inconsistent() bool {
+	return bool(C.Z3_goal_inconsistent(g.rawCtx, g.rawGoal))
+}
+
+// IsDecidedSat returns true if the goal is empty and precise, so the
+// original goal is satisfiable.
+//
+// Maps to: Z3_goal_is_decided_sat
+func (g *Goal) IsDecidedSat() bool {
+	return bool(C.Z3_goal_is_decided_sat(g.rawCtx, g.rawGoal))
+}
+
+// IsDecidedUnsat returns true if the goal contains false and is precise
+// or an over-approximation, so the original goal is unsatisfiable.
+//
+// Maps to: Z3_goal_is_decided_unsat
+func (g *Goal) IsDecidedUnsat() bool {
+	return bool(C.Z3_goal_is_decided_unsat(g.rawCtx, g.rawGoal))
+}
+
+// Reset removes all the formulas from the goal.
+//
+// Maps to: Z3_goal_reset
+func (g *Goal) Reset() {
+	C.Z3_goal_reset(g.rawCtx, g.rawGoal)
+}
+
+// ConvertModel converts a model of this goal into a model of the goal
+// the tactics were originally applied to. This undoes the
+// transformations the tactics made, such as eliminating variables. If m
+// is nil, the empty model is converted, which is useful for goals that
+// are decided sat.
+//
+// The returned model must be closed when it is no longer needed.
+//
+// Maps to: Z3_goal_convert_model
+func (g *Goal) ConvertModel(m *Model) *Model {
+	var raw C.Z3_model
+	if m != nil {
+		raw = m.rawModel
+	} else {
+		raw = C.Z3_mk_model(g.rawCtx)
+		C.Z3_model_inc_ref(g.rawCtx, raw)
+		defer C.Z3_model_dec_ref(g.rawCtx, raw)
+	}
+
+	result := &Model{
+		rawCtx:   g.rawCtx,
+		rawModel: C.Z3_goal_convert_model(g.rawCtx, g.rawGoal, raw),
+	}
+	result.IncRef()
+	return result
+}
+
+// newGoal wraps a raw goal, taking a reference to it.
+func newGoal(rawCtx C.Z3_context, raw C.Z3_goal) *Goal {
+	C.Z3_goal_inc_ref(rawCtx, raw)
+	return &Goal{
+		rawCtx:  rawCtx,
+		rawGoal: raw,
+	}
+}
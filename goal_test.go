@@ -0,0 +1,90 @@
+package z3
+
+import (
+	"testing"
+)
+
+func TestGoal(t *testing.T) {
+	config := NewConfig()
+	defer config.Close()
+	ctx := NewContext(config)
+	defer ctx.Close()
+
+	// Create the goal
+	g := ctx.NewGoal(true, false, false)
+	defer g.Close()
+
+	x := ctx.Const(ctx.Symbol("x"), ctx.IntSort())
+	y := ctx.Const(ctx.Symbol("y"), ctx.IntSort())
+	g.Assert(x.Gt(y))
+	g.Assert(y.Gt(ctx.Int(0, ctx.IntSort())))
+
+	if v := g.Size(); v != 2 {
+		t.Fatalf("bad: %d", v)
+	}
+	if v := g.Formulas(); len(v) != 2 || v[0].String() != "(> x y)" {
+		t.Fatalf("bad: %v", v)
+	}
+	if v := g.NumExprs(); v != 5 {
+		t.Fatalf("bad: %d", v)
+	}
+	if v := g.Precision(); v != GoalPrecise {
+		t.Fatalf("bad: %v", v)
+	}
+	if v := g.Depth(); v != 0 {
+		t.Fatalf("bad: %d", v)
+	}
+	if g.Inconsistent() || g.IsDecidedSat() || g.IsDecidedUnsat() {
+		t.Fatalf("bad: %s", g)
+	}
+
+	// Asserting false decides the goal
+	g.Assert(ctx.False())
+	if !g.Inconsistent() || !g.IsDecidedUnsat() {
+		t.Fatalf("bad: %s", g)
+	}
+
+	// Reset empties it again
+	g.Reset()
+	if v := g.Size(); v != 0 || !g.IsDecidedSat() {
+		t.Fatalf("bad: %s", g)
+	}
+}
+
+func TestGoalConvertModel(t *testing.T) {
+	config := NewConfig()
+	defer config.Close()
+	ctx := NewContext(config)
+	defer ctx.Close()
+
+	// x = 5 is solved entirely by eliminating x
+	x := ctx.Const(ctx.Symbol("x"), ctx.IntSort())
+	g := ctx.NewGoal(true, false, false)
+	defer g.Close()
+	g.Assert(x.Eq(ctx.Int(5, ctx.IntSort())))
+
+	tac, err := ctx.Tactic("solve-eqs")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer tac.Close()
+
+	r, err := tac.Apply(g)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer r.Close()
+
+	sub := r.Subgoal(0)
+	defer sub.Close()
+	if !sub.IsDecidedSat() {
+		t.Fatalf("bad: %s", sub)
+	}
+
+	// Converting the empty model recovers x
+	m := sub.ConvertModel(nil)
+	defer m.Close()
+	if v := m.Eval(x).Int(); v != 5 {
+		t.Fatalf("bad: %d", v)
+	}
+}
@@ -63,20 +63,39 @@ func (m *Model) Assignments() map[string]*AST {
 		decl := m.ConstDecl(i)
 
 		// Get the name of it, i.e. "x"
-		name := decl.DeclName()
+		name := decl.Name()
 
-		// Get the assignment for this
-		ast := C.Z3_model_get_const_interp(
-			m.rawCtx, m.rawModel, C.Z3_to_func_decl(decl.rawCtx, decl.rawAST))
+		// Map it to the assignment
+		result[name.String()] = m.ConstInterp(decl)
+	}
 
-		// Map it
-		result[name.String()] = &AST{
-			rawCtx: m.rawCtx,
-			rawAST: ast,
+	return result
+}
+
+// Substitute replaces every constant in a that is assigned in the model
+// with its value. Unlike Eval, the result isn't simplified and
+// constants without an assignment are left in place, so this can be
+// used to partially instantiate a formula with a model. Function
+// interpretations aren't substituted.
+//
+// This doesn't map to any specific Z3 API. It calls AST.Substitute with
+// the constant assignments of the model.
+func (m *Model) Substitute(a *AST) *AST {
+	n := m.NumConsts()
+	from := make([]*AST, 0, n)
+	to := make([]*AST, 0, n)
+	for i := uint(0); i < n; i++ {
+		decl := m.ConstDecl(i)
+		v := m.ConstInterp(decl)
+		if v == nil {
+			continue
 		}
+
+		from = append(from, decl.Apply())
+		to = append(to, v)
 	}
 
-	return result
+	return a.Substitute(from, to)
 }
 
 // NumConsts returns the number of constant assignments.
@@ -90,13 +109,304 @@ func (m *Model) NumConsts() uint {
 // be less than NumConsts.
 //
 // Maps: Z3_model_get_const_decl
-func (m *Model) ConstDecl(idx uint) *AST {
+func (m *Model) ConstDecl(idx uint) *FuncDecl {
+	return &FuncDecl{
+		rawCtx:      m.rawCtx,
+		rawFuncDecl: C.Z3_model_get_const_decl(m.rawCtx, m.rawModel, C.uint(idx)),
+	}
+}
+
+// ConstInterp returns the assignment of the constant declared by d. This
+// will return nil if the model has no assignment for d.
+//
+// Maps: Z3_model_get_const_interp
+func (m *Model) ConstInterp(d *FuncDecl) *AST {
+	raw := C.Z3_model_get_const_interp(m.rawCtx, m.rawModel, d.rawFuncDecl)
+	if raw == nil {
+		return nil
+	}
+
 	return &AST{
 		rawCtx: m.rawCtx,
-		rawAST: C.Z3_func_decl_to_ast(
-			m.rawCtx,
-			C.Z3_model_get_const_decl(m.rawCtx, m.rawModel, C.uint(idx))),
+		rawAST: raw,
+	}
+}
+
+//-------------------------------------------------------------------
+// Functions
+//-------------------------------------------------------------------
+
+// FuncInterp is the interpretation of a function within a model. The
+// function maps the arguments of each entry in Entries to its value and
+// all other arguments to Else. Else may be nil for partial functions.
+type FuncInterp struct {
+	Entries []FuncEntry
+	Else    *AST
+}
+
+// FuncEntry is a single set of arguments and the resulting value within
+// a FuncInterp.
+type FuncEntry struct {
+	Args  []*AST
+	Value *AST
+}
+
+// NumFuncs returns the number of function interpretations in the model.
+//
+// Maps: Z3_model_get_num_funcs
+func (m *Model) NumFuncs() uint {
+	return uint(C.Z3_model_get_num_funcs(m.rawCtx, m.rawModel))
+}
+
+// FuncDecl returns the function declaration for the given index. idx
+// must be less than NumFuncs.
+//
+// Maps: Z3_model_get_func_decl
+func (m *Model) FuncDecl(idx uint) *FuncDecl {
+	return &FuncDecl{
+		rawCtx:      m.rawCtx,
+		rawFuncDecl: C.Z3_model_get_func_decl(m.rawCtx, m.rawModel, C.uint(idx)),
+	}
+}
+
+// FuncInterp returns the interpretation of the function declared by d.
+// This will return nil if the model has no interpretation for d.
+//
+// Maps: Z3_model_get_func_interp
+func (m *Model) FuncInterp(d *FuncDecl) *FuncInterp {
+	fi := C.Z3_model_get_func_interp(m.rawCtx, m.rawModel, d.rawFuncDecl)
+	if fi == nil {
+		return nil
+	}
+	C.Z3_func_interp_inc_ref(m.rawCtx, fi)
+	defer C.Z3_func_interp_dec_ref(m.rawCtx, fi)
+
+	n := C.Z3_func_interp_get_num_entries(m.rawCtx, fi)
+	result := &FuncInterp{
+		Entries: make([]FuncEntry, 0, n),
+	}
+	if raw := C.Z3_func_interp_get_else(m.rawCtx, fi); raw != nil {
+		result.Else = &AST{
+			rawCtx: m.rawCtx,
+			rawAST: raw,
+		}
+	}
+	for i := C.uint(0); i < n; i++ {
+		e := C.Z3_func_interp_get_entry(m.rawCtx, fi, i)
+		C.Z3_func_entry_inc_ref(m.rawCtx, e)
+
+		numArgs := C.Z3_func_entry_get_num_args(m.rawCtx, e)
+		entry := FuncEntry{
+			Args: make([]*AST, numArgs),
+			Value: &AST{
+				rawCtx: m.rawCtx,
+				rawAST: C.Z3_func_entry_get_value(m.rawCtx, e),
+			},
+		}
+		for j := C.uint(0); j < numArgs; j++ {
+			entry.Args[j] = &AST{
+				rawCtx: m.rawCtx,
+				rawAST: C.Z3_func_entry_get_arg(m.rawCtx, e, j),
+			}
+		}
+
+		C.Z3_func_entry_dec_ref(m.rawCtx, e)
+		result.Entries = append(result.Entries, entry)
+	}
+
+	return result
+}
+
+//-------------------------------------------------------------------
+// Arrays
+//-------------------------------------------------------------------
+
+// ArrayInterp is the interpretation of an array within a model. The
+// array maps each index in Entries to its value and every other index
+// to Else.
+type ArrayInterp struct {
+	Entries []ArrayEntry
+	Else    *AST
+}
+
+// ArrayEntry is a single index and value within an ArrayInterp.
+type ArrayEntry struct {
+	Index *AST
+	Value *AST
+}
+
+// ArrayInterp returns the interpretation of the array a within the model
+// as a finite table of entries plus a default value. This will return
+// nil if a doesn't evaluate to an array with a finite interpretation or
+// if a is multi-dimensional, with more than one index per entry.
+//
+// This doesn't map to any specific Z3 API. Z3 represents array values
+// as chains of stores on top of a constant array, as a reference to a
+// function interpretation or as a map over other array values; all of
+// these are decoded here.
+func (m *Model) ArrayInterp(a *AST) *ArrayInterp {
+	v := m.Eval(a)
+	if v == nil {
+		return nil
+	}
+
+	result := &ArrayInterp{}
+	seen := make(map[C.uint]struct{})
+	add := func(idx, value C.Z3_ast) {
+		// Stores closer to the top shadow those beneath them
+		id := C.Z3_get_ast_id(m.rawCtx, idx)
+		if _, ok := seen[id]; ok {
+			return
+		}
+		seen[id] = struct{}{}
+
+		result.Entries = append(result.Entries, ArrayEntry{
+			Index: &AST{rawCtx: m.rawCtx, rawAST: idx},
+			Value: &AST{rawCtx: m.rawCtx, rawAST: value},
+		})
+	}
+
+	raw := v.rawAST
+	for {
+		if bool(C.Z3_is_as_array(m.rawCtx, raw)) {
+			fi := m.FuncInterp(&FuncDecl{
+				rawCtx:      m.rawCtx,
+				rawFuncDecl: C.Z3_get_as_array_func_decl(m.rawCtx, raw),
+			})
+			if fi == nil {
+				return nil
+			}
+
+			for _, e := range fi.Entries {
+				// Multi-dimensional arrays have an argument per index
+				if len(e.Args) != 1 {
+					return nil
+				}
+
+				add(e.Args[0].rawAST, e.Value.rawAST)
+			}
+
+			result.Else = fi.Else
+			return result
+		}
+
+		if C.Z3_get_ast_kind(m.rawCtx, raw) != C.Z3_APP_AST {
+			return nil
+		}
+
+		app := C.Z3_to_app(m.rawCtx, raw)
+		switch C.Z3_get_decl_kind(m.rawCtx, C.Z3_get_app_decl(m.rawCtx, app)) {
+		case C.Z3_OP_STORE:
+			// Multi-dimensional stores are (store a i j ... v)
+			if C.Z3_get_app_num_args(m.rawCtx, app) != 3 {
+				return nil
+			}
+
+			add(
+				C.Z3_get_app_arg(m.rawCtx, app, 1),
+				C.Z3_get_app_arg(m.rawCtx, app, 2))
+			raw = C.Z3_get_app_arg(m.rawCtx, app, 0)
+
+		case C.Z3_OP_CONST_ARRAY:
+			result.Else = &AST{
+				rawCtx: m.rawCtx,
+				rawAST: C.Z3_get_app_arg(m.rawCtx, app, 0),
+			}
+			return result
+
+		case C.Z3_OP_ARRAY_MAP:
+			// Z3 doesn't reduce maps within model values, so apply the
+			// function pointwise to every index the arguments mention.
+			decl := C.Z3_get_app_decl(m.rawCtx, app)
+			f := &FuncDecl{
+				rawCtx:      m.rawCtx,
+				rawFuncDecl: C.Z3_get_decl_func_decl_parameter(m.rawCtx, decl, 0),
+			}
+
+			args := make([]*ArrayInterp, C.Z3_get_app_num_args(m.rawCtx, app))
+			for i := range args {
+				args[i] = m.ArrayInterp(&AST{
+					rawCtx: m.rawCtx,
+					rawAST: C.Z3_get_app_arg(m.rawCtx, app, C.uint(i)),
+				})
+				if args[i] == nil {
+					return nil
+				}
+			}
+
+			apply := func(idx *AST) *AST {
+				values := make([]*AST, len(args))
+				for i, arg := range args {
+					if values[i] = arg.lookup(idx); values[i] == nil {
+						return nil
+					}
+				}
+
+				return m.Eval(f.Apply(values...))
+			}
+
+			for _, arg := range args {
+				for _, e := range arg.Entries {
+					v := apply(e.Index)
+					if v == nil {
+						return nil
+					}
+
+					add(e.Index.rawAST, v.rawAST)
+				}
+			}
+
+			if result.Else = apply(nil); result.Else == nil {
+				return nil
+			}
+			return result
+
+		default:
+			return nil
+		}
+	}
+}
+
+// lookup returns the value of the given index, or Else if idx is nil or
+// has no entry.
+func (i *ArrayInterp) lookup(idx *AST) *AST {
+	if idx != nil {
+		id := C.Z3_get_ast_id(idx.rawCtx, idx.rawAST)
+		for _, e := range i.Entries {
+			if C.Z3_get_ast_id(e.Index.rawCtx, e.Index.rawAST) == id {
+				return e.Value
+			}
+		}
+	}
+
+	return i.Else
+}
+
+// SetMembers returns the members of the set a within the model. The
+// second return value is false if a doesn't evaluate to a finite set,
+// such as the complement of a finite set.
+//
+// This doesn't map to any specific Z3 API. Sets are arrays to booleans,
+// so this is built on top of ArrayInterp.
+func (m *Model) SetMembers(a *AST) ([]*AST, bool) {
+	interp := m.ArrayInterp(a)
+	if interp == nil || interp.Else == nil ||
+		C.Z3_get_bool_value(m.rawCtx, interp.Else.rawAST) != C.Z3_L_FALSE {
+		return nil, false
+	}
+
+	var result []*AST
+	for _, e := range interp.Entries {
+		switch C.Z3_get_bool_value(m.rawCtx, e.Value.rawAST) {
+		case C.Z3_L_TRUE:
+			result = append(result, e.Index)
+		case C.Z3_L_FALSE:
+		default:
+			return nil, false
+		}
 	}
+
+	return result, true
 }
 
 //-------------------------------------------------------------------
@@ -1,6 +1,7 @@
 package z3
 
 import (
+	"math/big"
 	"testing"
 )
 
@@ -86,3 +87,246 @@ func TestModelEval(t *testing.T) {
 		t.Fatalf("bad: %s", assign)
 	}
 }
+
+func TestModelEvalRat(t *testing.T) {
+	config := NewConfig()
+	defer config.Close()
+
+	ctx := NewContext(config)
+	defer ctx.Close()
+
+	// Create a symbol
+	x := ctx.Const(ctx.Symbol("x"), ctx.RealSort())
+
+	// x * 3 = 123456789012345678901234567891
+	v := ctx.Numeral("123456789012345678901234567891", ctx.RealSort())
+	ast := x.Mul(ctx.Real(3, 1)).Eq(v)
+
+	// Create the solver
+	s := ctx.NewSolver()
+	defer s.Close()
+
+	// Assert constraints
+	s.Assert(ast)
+
+	// Solve
+	result := s.Check()
+	if result != True {
+		t.Fatalf("bad: %v", result)
+	}
+
+	// Get the model
+	m := s.Model()
+	defer m.Close()
+
+	// Get the exact value
+	assign := m.Eval(x)
+	if v := assign.Rat().RatString(); v != "123456789012345678901234567891/3" {
+		t.Fatalf("bad: %s", v)
+	}
+
+	// Check that fractions round trip
+	r := ctx.Rat(big.NewRat(-7, 12)).Rat()
+	if r.RatString() != "-7/12" {
+		t.Fatalf("bad: %s", r)
+	}
+}
+
+func TestModelArrayInterp(t *testing.T) {
+	config := NewConfig()
+	defer config.Close()
+
+	ctx := NewContext(config)
+	defer ctx.Close()
+
+	// Create an array
+	a := ctx.Const(ctx.Symbol("a"), ctx.ArraySort(ctx.IntSort(), ctx.IntSort()))
+
+	// Create the solver
+	s := ctx.NewSolver()
+	defer s.Close()
+
+	// a[1] = 5, a[2] = 7
+	s.Assert(a.Select(ctx.Int(1, ctx.IntSort())).Eq(ctx.Int(5, ctx.IntSort())))
+	s.Assert(a.Select(ctx.Int(2, ctx.IntSort())).Eq(ctx.Int(7, ctx.IntSort())))
+
+	// Solve
+	result := s.Check()
+	if result != True {
+		t.Fatalf("bad: %v", result)
+	}
+
+	// Get the model
+	m := s.Model()
+	defer m.Close()
+	t.Logf("\nModel:\n%s", m.String())
+
+	interp := m.ArrayInterp(a)
+	if interp == nil {
+		t.Fatal("should have interpretation")
+	}
+	if interp.Else == nil {
+		t.Fatal("should have else value")
+	}
+
+	// Z3 may fold one of the entries into the else value, so look up
+	// each index the way the array would.
+	lookup := func(idx int) int {
+		for _, e := range interp.Entries {
+			if e.Index.Int() == idx {
+				return e.Value.Int()
+			}
+		}
+
+		return interp.Else.Int()
+	}
+	if v := lookup(1); v != 5 {
+		t.Fatalf("bad: %d", v)
+	}
+	if v := lookup(2); v != 7 {
+		t.Fatalf("bad: %d", v)
+	}
+
+	// Constant arrays decode too
+	c := ctx.ConstArray(ctx.IntSort(), ctx.Int(3, ctx.IntSort())).
+		Store(ctx.Int(1, ctx.IntSort()), ctx.Int(4, ctx.IntSort()))
+	interp = m.ArrayInterp(c)
+	if interp == nil || len(interp.Entries) != 1 || interp.Else.Int() != 3 {
+		t.Fatalf("bad: %#v", interp)
+	}
+}
+
+func TestModelFuncInterp(t *testing.T) {
+	config := NewConfig()
+	defer config.Close()
+
+	ctx := NewContext(config)
+	defer ctx.Close()
+
+	// f : Int -> Int
+	f := ctx.FuncDecl(ctx.Symbol("f"), []*Sort{ctx.IntSort()}, ctx.IntSort())
+	one := ctx.Int(1, ctx.IntSort())
+	two := ctx.Int(2, ctx.IntSort())
+
+	// Create the solver
+	s := ctx.NewSolver()
+	defer s.Close()
+
+	// f(1) = 2, f(2) = 1
+	s.Assert(f.Apply(one).Eq(two))
+	s.Assert(f.Apply(two).Eq(one))
+
+	// Solve
+	result := s.Check()
+	if result != True {
+		t.Fatalf("bad: %v", result)
+	}
+
+	// Get the model
+	m := s.Model()
+	defer m.Close()
+	t.Logf("\nModel:\n%s", m.String())
+
+	if v := m.NumFuncs(); v != 1 {
+		t.Fatalf("bad: %d", v)
+	}
+	if v := m.FuncDecl(0).Name().String(); v != "f" {
+		t.Fatalf("bad: %s", v)
+	}
+
+	interp := m.FuncInterp(f)
+	if interp == nil {
+		t.Fatal("should have interpretation")
+	}
+
+	// Z3 may fold one of the entries into the else value
+	lookup := func(arg int) int {
+		for _, e := range interp.Entries {
+			if e.Args[0].Int() == arg {
+				return e.Value.Int()
+			}
+		}
+
+		return interp.Else.Int()
+	}
+	if v := lookup(1); v != 2 {
+		t.Fatalf("bad: %d", v)
+	}
+	if v := lookup(2); v != 1 {
+		t.Fatalf("bad: %d", v)
+	}
+}
+
+func TestModelSubstitute(t *testing.T) {
+	config := NewConfig()
+	defer config.Close()
+	ctx := NewContext(config)
+	defer ctx.Close()
+
+	// Create the solver
+	s := ctx.NewSolver()
+	defer s.Close()
+
+	// x = 3
+	x := ctx.Const(ctx.Symbol("x"), ctx.IntSort())
+	y := ctx.Const(ctx.Symbol("y"), ctx.IntSort())
+	s.Assert(x.Eq(ctx.Int(3, ctx.IntSort())))
+
+	if v := s.Check(); v != True {
+		t.Fatalf("bad: %v", v)
+	}
+
+	// Get the model
+	m := s.Model()
+	defer m.Close()
+
+	// x is replaced but y is left alone and nothing is simplified
+	raw := m.Substitute(x.Add(ctx.Int(1, ctx.IntSort())).Lt(y))
+
+	actual := raw.String()
+	if actual != "(< (+ 3 1) y)" {
+		t.Fatalf("bad:\n%s", actual)
+	}
+}
+
+func TestModelArrayInterp_multiDimensional(t *testing.T) {
+	config := NewConfig()
+	defer config.Close()
+	ctx := NewContext(config)
+	defer ctx.Close()
+
+	// Two-dimensional arrays can only be declared through SMT-LIB
+	assertions, err := ctx.ParseSMTLIB2String(`
+(declare-const a (Array Int Int Int))
+(declare-const b (Array Int Int Int))
+(assert (= (select a 1 2) 5))
+(assert (= b (store ((as const (Array Int Int Int)) 0) 1 2 5)))
+`, nil, nil)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	// Create the solver
+	s := ctx.NewSolver()
+	defer s.Close()
+	for _, a := range assertions {
+		s.Assert(a)
+	}
+
+	if v := s.Check(); v != True {
+		t.Fatalf("bad: %v", v)
+	}
+
+	// Get the model
+	m := s.Model()
+	defer m.Close()
+
+	// Neither a function interpretation nor a store chain is decoded
+	a := assertions[0].Arg(0).Arg(0)
+	b := assertions[1].Arg(0)
+	for _, v := range []*AST{a, b} {
+		if interp := m.ArrayInterp(v); interp != nil {
+			t.Fatalf("bad: %s: %v", v, interp.Entries)
+		}
+	}
+}
@@ -0,0 +1,228 @@
+package z3
+
+import (
+	"unsafe"
+)
+
+// #include <stdlib.h>
+// #include "go-z3.h"
+import "C"
+
+// Optimize is a solver that, in addition to checking satisfiability,
+// finds models that are optimal with respect to a set of objectives.
+//
+// It is created via the NewOptimize method on Context. When it is no
+// longer needed, the Close method must be called, the same as Solver.
+type Optimize struct {
+	rawCtx      C.Z3_context
+	rawOptimize C.Z3_optimize
+}
+
+// Objective is a handle to an objective created by Optimize.Maximize,
+// Optimize.Minimize or Optimize.AssertSoft. Its bounds are available
+// after a call to Optimize.Check.
+type Objective struct {
+	rawCtx      C.Z3_context
+	rawOptimize C.Z3_optimize
+	idx         C.uint
+}
+
+// OptimizePriority is the way multiple objectives are combined. It is
+// set with Optimize.SetPriority.
+type OptimizePriority string
+
+const (
+	// PriorityLex optimizes objectives in the order they were created.
+	// Later objectives are only optimized among the models that are
+	// optimal for earlier ones. This is the default.
+	PriorityLex OptimizePriority = "lex"
+
+	// PriorityPareto finds Pareto optimal models. Each call to Check
+	// returns a new model on the Pareto front until none remain.
+	PriorityPareto OptimizePriority = "pareto"
+
+	// PriorityBox optimizes each objective independently. The model
+	// only reflects the last objective, but the bounds of every
+	// objective are available.
+	PriorityBox OptimizePriority = "box"
+)
+
+// NewOptimize creates a new optimizer.
+//
+// Maps: Z3_mk_optimize
+func (c *Context) NewOptimize() *Optimize {
+	rawOptimize := C.Z3_mk_optimize(c.raw)
+	C.Z3_optimize_inc_ref(c.raw, rawOptimize)
+
+	return &Optimize{
+		rawCtx:      c.raw,
+		rawOptimize: rawOptimize,
+	}
+}
+
+// Close frees the memory associated with this.
+func (o *Optimize) Close() error {
+	C.Z3_optimize_dec_ref(o.rawCtx, o.rawOptimize)
+	return nil
+}
+
+// String returns a human-friendly string version of the optimizer in
+// the SMT-LIB2 format.
+//
+// Maps to: Z3_optimize_to_string
+func (o *Optimize) String() string {
+	return C.GoString(C.Z3_optimize_to_string(o.rawCtx, o.rawOptimize))
+}
+
+// Assert asserts a hard constraint onto the optimizer.
+//
+// Maps to: Z3_optimize_assert
+func (o *Optimize) Assert(a *AST) {
+	C.Z3_optimize_assert(o.rawCtx, o.rawOptimize, a.rawAST)
+}
+
+// AssertSoft asserts a soft constraint onto the optimizer. The optimizer
+// minimizes the total weight of the soft constraints that are violated.
+//
+// weight is a positive number formatted as a string, such as "1" or
+// "2.5". Soft constraints with the same group share an objective, which
+// is returned. An empty group uses the default group.
+//
+// Maps to: Z3_optimize_assert_soft
+func (o *Optimize) AssertSoft(a *AST, weight string, group string) *Objective {
+	cw := C.CString(weight)
+	defer C.free(unsafe.Pointer(cw))
+
+	var id C.Z3_symbol
+	if group != "" {
+		cg := C.CString(group)
+		defer C.free(unsafe.Pointer(cg))
+		id = C.Z3_mk_string_symbol(o.rawCtx, cg)
+	}
+
+	return &Objective{
+		rawCtx:      o.rawCtx,
+		rawOptimize: o.rawOptimize,
+		idx:         C.Z3_optimize_assert_soft(o.rawCtx, o.rawOptimize, a.rawAST, cw, id),
+	}
+}
+
+// Maximize adds an objective to maximize the value of a.
+//
+// Maps to: Z3_optimize_maximize
+func (o *Optimize) Maximize(a *AST) *Objective {
+	return &Objective{
+		rawCtx:      o.rawCtx,
+		rawOptimize: o.rawOptimize,
+		idx:         C.Z3_optimize_maximize(o.rawCtx, o.rawOptimize, a.rawAST),
+	}
+}
+
+// Minimize adds an objective to minimize the value of a.
+//
+// Maps to: Z3_optimize_minimize
+func (o *Optimize) Minimize(a *AST) *Objective {
+	return &Objective{
+		rawCtx:      o.rawCtx,
+		rawOptimize: o.rawOptimize,
+		idx:         C.Z3_optimize_minimize(o.rawCtx, o.rawOptimize, a.rawAST),
+	}
+}
+
+// SetPriority sets how multiple objectives are combined. See
+// OptimizePriority for the available values.
+//
+// Maps to: Z3_optimize_set_params
+func (o *Optimize) SetPriority(p OptimizePriority) {
+	ck := C.CString("priority")
+	defer C.free(unsafe.Pointer(ck))
+	cv := C.CString(string(p))
+	defer C.free(unsafe.Pointer(cv))
+
+	params := C.Z3_mk_params(o.rawCtx)
+	C.Z3_params_inc_ref(o.rawCtx, params)
+	defer C.Z3_params_dec_ref(o.rawCtx, params)
+
+	C.Z3_params_set_symbol(
+		o.rawCtx, params,
+		C.Z3_mk_string_symbol(o.rawCtx, ck),
+		C.Z3_mk_string_symbol(o.rawCtx, cv))
+	C.Z3_optimize_set_params(o.rawCtx, o.rawOptimize, params)
+}
+
+// Check checks if the hard constraints are consistent, along with the
+// given assumptions, and if so finds an optimal model.
+//
+// Maps to: Z3_optimize_check
+func (o *Optimize) Check(assumptions ...*AST) LBool {
+	raws := make([]C.Z3_ast, len(assumptions))
+	for i, a := range assumptions {
+		raws[i] = a.rawAST
+	}
+
+	var rawAssumptions *C.Z3_ast
+	if len(raws) > 0 {
+		rawAssumptions = (*C.Z3_ast)(unsafe.Pointer(&raws[0]))
+	}
+
+	return LBool(C.Z3_optimize_check(
+		o.rawCtx, o.rawOptimize, C.uint(len(raws)), rawAssumptions))
+}
+
+// Model returns the last model from a Check.
+//
+// Maps to: Z3_optimize_get_model
+func (o *Optimize) Model() *Model {
+	m := &Model{
+		rawCtx:   o.rawCtx,
+		rawModel: C.Z3_optimize_get_model(o.rawCtx, o.rawOptimize),
+	}
+	m.IncRef()
+	return m
+}
+
+// ReasonUnknown returns a description of why the last Check returned
+// Undef.
+//
+// Maps to: Z3_optimize_get_reason_unknown
+func (o *Optimize) ReasonUnknown() string {
+	return C.GoString(C.Z3_optimize_get_reason_unknown(o.rawCtx, o.rawOptimize))
+}
+
+// Push creates a backtracking point. All assertions and objectives added
+// after the call to Push are removed by the matching call to Pop.
+//
+// Maps to: Z3_optimize_push
+func (o *Optimize) Push() {
+	C.Z3_optimize_push(o.rawCtx, o.rawOptimize)
+}
+
+// Pop removes the most recent backtracking point created by Push.
+//
+// Maps to: Z3_optimize_pop
+func (o *Optimize) Pop() {
+	C.Z3_optimize_pop(o.rawCtx, o.rawOptimize)
+}
+
+// Lower returns the lower bound of the objective found by the last
+// Check. Unbounded values are represented by terms involving "oo" and
+// "epsilon".
+//
+// Maps to: Z3_optimize_get_lower
+func (o *Objective) Lower() *AST {
+	return &AST{
+		rawCtx: o.rawCtx,
+		rawAST: C.Z3_optimize_get_lower(o.rawCtx, o.rawOptimize, o.idx),
+	}
+}
+
+// Upper returns the upper bound of the objective found by the last
+// Check. Unbounded values are represented the same way as with Lower.
+//
+// Maps to: Z3_optimize_get_upper
+func (o *Objective) Upper() *AST {
+	return &AST{
+		rawCtx: o.rawCtx,
+		rawAST: C.Z3_optimize_get_upper(o.rawCtx, o.rawOptimize, o.idx),
+	}
+}
@@ -0,0 +1,144 @@
+package z3
+
+import (
+	"testing"
+)
+
+func TestOptimizeMaximize(t *testing.T) {
+	config := NewConfig()
+	defer config.Close()
+
+	ctx := NewContext(config)
+	defer ctx.Close()
+
+	// Create the optimizer
+	o := ctx.NewOptimize()
+	defer o.Close()
+
+	// x <= 10, y <= 5, x + y < 12
+	x := ctx.Const(ctx.Symbol("x"), ctx.IntSort())
+	y := ctx.Const(ctx.Symbol("y"), ctx.IntSort())
+	o.Assert(x.Le(ctx.Int(10, ctx.IntSort())))
+	o.Assert(y.Le(ctx.Int(5, ctx.IntSort())))
+	o.Assert(x.Add(y).Lt(ctx.Int(12, ctx.IntSort())))
+
+	// Maximize x + y
+	obj := o.Maximize(x.Add(y))
+	if v := o.Check(); v != True {
+		t.Fatalf("bad: %v", v)
+	}
+
+	if v := obj.Upper().Int(); v != 11 {
+		t.Fatalf("bad: %d", v)
+	}
+
+	m := o.Model()
+	defer m.Close()
+	if v := m.Eval(x.Add(y)).Int(); v != 11 {
+		t.Fatalf("bad: %d", v)
+	}
+}
+
+func TestOptimizeAssertSoft(t *testing.T) {
+	config := NewConfig()
+	defer config.Close()
+
+	ctx := NewContext(config)
+	defer ctx.Close()
+
+	// Create the optimizer
+	o := ctx.NewOptimize()
+	defer o.Close()
+
+	// Conflicting soft constraints, where x < 0 weighs more
+	x := ctx.Const(ctx.Symbol("x"), ctx.IntSort())
+	zero := ctx.Int(0, ctx.IntSort())
+	o.AssertSoft(x.Gt(zero), "1", "")
+	obj := o.AssertSoft(x.Lt(zero), "2.5", "")
+
+	if v := o.Check(); v != True {
+		t.Fatalf("bad: %v", v)
+	}
+
+	m := o.Model()
+	defer m.Close()
+	if v := m.Eval(x).Int(); v >= 0 {
+		t.Fatalf("bad: %d", v)
+	}
+
+	// The cost is the weight of the violated constraint
+	if v := obj.Lower().String(); v != "1" {
+		t.Fatalf("bad: %s", v)
+	}
+}
+
+func TestOptimizePushPop(t *testing.T) {
+	config := NewConfig()
+	defer config.Close()
+
+	ctx := NewContext(config)
+	defer ctx.Close()
+
+	// Create the optimizer
+	o := ctx.NewOptimize()
+	defer o.Close()
+
+	x := ctx.Const(ctx.Symbol("x"), ctx.IntSort())
+	o.Assert(x.Le(ctx.Int(10, ctx.IntSort())))
+	o.Assert(x.Ge(ctx.Int(0, ctx.IntSort())))
+
+	// Minimize within a scope
+	o.Push()
+	obj := o.Minimize(x)
+	if v := o.Check(); v != True {
+		t.Fatalf("bad: %v", v)
+	}
+	if v := obj.Lower().Int(); v != 0 {
+		t.Fatalf("bad: %d", v)
+	}
+	o.Pop()
+
+	// Maximize after the scope is gone
+	obj = o.Maximize(x)
+	if v := o.Check(); v != True {
+		t.Fatalf("bad: %v", v)
+	}
+	if v := obj.Upper().Int(); v != 10 {
+		t.Fatalf("bad: %d", v)
+	}
+}
+
+func TestOptimizeSetPriority(t *testing.T) {
+	config := NewConfig()
+	defer config.Close()
+
+	ctx := NewContext(config)
+	defer ctx.Close()
+
+	// Create the optimizer
+	o := ctx.NewOptimize()
+	defer o.Close()
+	o.SetPriority(PriorityBox)
+
+	// x + y <= 10 with both non-negative
+	x := ctx.Const(ctx.Symbol("x"), ctx.IntSort())
+	y := ctx.Const(ctx.Symbol("y"), ctx.IntSort())
+	zero := ctx.Int(0, ctx.IntSort())
+	o.Assert(x.Ge(zero))
+	o.Assert(y.Ge(zero))
+	o.Assert(x.Add(y).Le(ctx.Int(10, ctx.IntSort())))
+
+	// With box priority each objective is optimized independently,
+	// so both reach 10 even though they can't at the same time.
+	objX := o.Maximize(x)
+	objY := o.Maximize(y)
+	if v := o.Check(); v != True {
+		t.Fatalf("bad: %v", v)
+	}
+	if v := objX.Upper().Int(); v != 10 {
+		t.Fatalf("bad: %d", v)
+	}
+	if v := objY.Upper().Int(); v != 10 {
+		t.Fatalf("bad: %d", v)
+	}
+}
@@ -0,0 +1,218 @@
+package z3
+
+import (
+	"fmt"
+	"unsafe"
+)
+
+// #include <stdlib.h>
+// #include "go-z3.h"
+import "C"
+
+// Params is a set of parameters used to configure solvers, tactics and
+// other Z3 objects. Unlike Config, which sets global parameters when a Context
+// is created, Params are applied to a single object.
+//
+// It is created via NewParams on Context. When the parameters are no
+// longer needed, the Close method must be called.
+type Params struct {
+	rawCtx    C.Z3_context
+	rawParams C.Z3_params
+}
+
+// NewParams creates a new, empty parameter set.
+//
+// Maps: Z3_mk_params
+func (c *Context) NewParams() *Params {
+	rawParams := C.Z3_mk_params(c.raw)
+	C.Z3_params_inc_ref(c.raw, rawParams)
+
+	return &Params{
+		rawCtx:    c.raw,
+		rawParams: rawParams,
+	}
+}
+
+// Close frees the memory associated with this.
+func (p *Params) Close() error {
+	C.Z3_params_dec_ref(p.rawCtx, p.rawParams)
+	return nil
+}
+
+// String returns a human-friendly string version of the parameters.
+func (p *Params) String() string {
+	return C.GoString(C.Z3_params_to_string(p.rawCtx, p.rawParams))
+}
+
+// SetBool sets a boolean parameter.
+//
+// Maps to: Z3_params_set_bool
+func (p *Params) SetBool(k string, v bool) {
+	C.Z3_params_set_bool(p.rawCtx, p.rawParams, p.symbol(k), C.bool(v))
+}
+
+// SetUint sets an unsigned integer parameter.
+//
+// Maps to: Z3_params_set_uint
+func (p *Params) SetUint(k string, v uint) {
+	C.Z3_params_set_uint(p.rawCtx, p.rawParams, p.symbol(k), C.uint(v))
+}
+
+// SetFloat sets a floating-point parameter.
+//
+// Maps to: Z3_params_set_double
+func (p *Params) SetFloat(k string, v float64) {
+	C.Z3_params_set_double(p.rawCtx, p.rawParams, p.symbol(k), C.double(v))
+}
+
+// SetSymbol sets a symbol parameter, such as the name of a logic or a
+// strategy.
+//
+// Maps to: Z3_params_set_symbol
+func (p *Params) SetSymbol(k, v string) {
+	C.Z3_params_set_symbol(p.rawCtx, p.rawParams, p.symbol(k), p.symbol(v))
+}
+
+// SetString would set a parameter of kind ParamString, such as the
+// solver's "qi.cost". The Z3 API has no way to set string values and Z3
+// rejects a symbol in their place, so this sets nothing and records an
+// ErrorCodeInvalidArg error on the Context instead. Use SetSymbol for
+// parameters of kind ParamSymbol.
+func (p *Params) SetString(k, v string) {
+	setError(p.rawCtx, &Error{
+		Code: ErrorCodeInvalidArg,
+		Message: fmt.Sprintf(
+			"z3: can't set string parameter %q: Z3 can't set string values", k),
+	})
+}
+
+// Validate checks that every parameter in p is described by d and has
+// the kind d expects. The returned error lists the valid parameters if a
+// name is unknown.
+//
+// Maps to: Z3_params_validate
+func (p *Params) Validate(d *ParamDescrs) error {
+	return withError(p.rawCtx, func() {
+		C.Z3_params_validate(p.rawCtx, p.rawParams, d.rawParamDescrs)
+	})
+}
+
+// symbol creates a string symbol for a parameter name or value.
+func (p *Params) symbol(name string) C.Z3_symbol {
+	cs := C.CString(name)
+	defer C.free(unsafe.Pointer(cs))
+	return C.Z3_mk_string_symbol(p.rawCtx, cs)
+}
+
+//-------------------------------------------------------------------
+// Parameter Descriptions
+//-------------------------------------------------------------------
+
+// ParamKind is the kind of value a parameter accepts.
+type ParamKind uint
+
+const (
+	ParamUint    ParamKind = C.Z3_PK_UINT
+	ParamBool              = C.Z3_PK_BOOL
+	ParamFloat             = C.Z3_PK_DOUBLE
+	ParamSymbol            = C.Z3_PK_SYMBOL
+	ParamString            = C.Z3_PK_STRING
+	ParamOther             = C.Z3_PK_OTHER
+	ParamInvalid           = C.Z3_PK_INVALID
+)
+
+// String returns a human-friendly name for the kind.
+func (k ParamKind) String() string {
+	switch k {
+	case ParamUint:
+		return "uint"
+	case ParamBool:
+		return "bool"
+	case ParamFloat:
+		return "float"
+	case ParamSymbol:
+		return "symbol"
+	case ParamString:
+		return "string"
+	case ParamOther:
+		return "other"
+	default:
+		return "invalid"
+	}
+}
+
+// ParamDescrs describes the parameters accepted by a solver, a tactic or
+// the simplifier. It is used to list the parameters and to check Params
+// with Params.Validate.
+//
+// When the descriptions are no longer needed, the Close method must be
+// called.
+type ParamDescrs struct {
+	rawCtx         C.Z3_context
+	rawParamDescrs C.Z3_param_descrs
+}
+
+// Close frees the memory associated with this.
+func (d *ParamDescrs) Close() error {
+	C.Z3_param_descrs_dec_ref(d.rawCtx, d.rawParamDescrs)
+	return nil
+}
+
+// String returns a human-friendly string version of the descriptions.
+func (d *ParamDescrs) String() string {
+	return C.GoString(C.Z3_param_descrs_to_string(d.rawCtx, d.rawParamDescrs))
+}
+
+// Size returns the number of parameters.
+//
+// Maps to: Z3_param_descrs_size
+func (d *ParamDescrs) Size() uint {
+	return uint(C.Z3_param_descrs_size(d.rawCtx, d.rawParamDescrs))
+}
+
+// Names returns the names of all the parameters.
+//
+// Maps to: Z3_param_descrs_get_name
+func (d *ParamDescrs) Names() []string {
+	result := make([]string, d.Size())
+	for i := range result {
+		sym := C.Z3_param_descrs_get_name(d.rawCtx, d.rawParamDescrs, C.uint(i))
+		result[i] = C.GoString(C.Z3_get_symbol_string(d.rawCtx, sym))
+	}
+
+	return result
+}
+
+// Kind returns the kind of the named parameter, or ParamInvalid if there
+// is no parameter with that name.
+//
+// Maps to: Z3_param_descrs_get_kind
+func (d *ParamDescrs) Kind(name string) ParamKind {
+	return ParamKind(C.Z3_param_descrs_get_kind(
+		d.rawCtx, d.rawParamDescrs, d.symbol(name)))
+}
+
+// Doc returns the documentation of the named parameter.
+//
+// Maps to: Z3_param_descrs_get_documentation
+func (d *ParamDescrs) Doc(name string) string {
+	return C.GoString(C.Z3_param_descrs_get_documentation(
+		d.rawCtx, d.rawParamDescrs, d.symbol(name)))
+}
+
+// symbol creates a string symbol for a parameter name.
+func (d *ParamDescrs) symbol(name string) C.Z3_symbol {
+	cs := C.CString(name)
+	defer C.free(unsafe.Pointer(cs))
+	return C.Z3_mk_string_symbol(d.rawCtx, cs)
+}
+
+// newParamDescrs wraps raw parameter descriptions, taking a reference to
+// them.
+func newParamDescrs(rawCtx C.Z3_context, raw C.Z3_param_descrs) *ParamDescrs {
+	C.Z3_param_descrs_inc_ref(rawCtx, raw)
+	return &ParamDescrs{
+		rawCtx:         rawCtx,
+		rawParamDescrs: raw,
+	}
+}
@@ -0,0 +1,129 @@
+package z3
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParams(t *testing.T) {
+	config := NewConfig()
+	defer config.Close()
+	ctx := NewContext(config)
+	defer ctx.Close()
+
+	p := ctx.NewParams()
+	defer p.Close()
+	p.SetBool("som", true)
+	p.SetUint("max_steps", 10)
+	p.SetFloat("som_blowup", 2.5)
+	p.SetSymbol("flat", "true")
+
+	actual := p.String()
+	if actual != "(params som true max_steps 10 som_blowup 2.5 flat true)" {
+		t.Fatalf("bad:\n%s", actual)
+	}
+}
+
+func TestParamsValidate(t *testing.T) {
+	config := NewConfig()
+	defer config.Close()
+	ctx := NewContext(config)
+	defer ctx.Close()
+
+	d := ctx.SimplifyParamDescrs()
+	defer d.Close()
+
+	// Valid parameters
+	valid := ctx.NewParams()
+	defer valid.Close()
+	valid.SetBool("som", true)
+	valid.SetUint("max_steps", 10)
+	if err := valid.Validate(d); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	// Misspelled parameter
+	misspelled := ctx.NewParams()
+	defer misspelled.Close()
+	misspelled.SetBool("smo", true)
+	if err := misspelled.Validate(d); err == nil || !strings.Contains(err.Error(), "smo") {
+		t.Fatalf("bad: %v", err)
+	}
+
+	// Wrong kind
+	wrongKind := ctx.NewParams()
+	defer wrongKind.Close()
+	wrongKind.SetBool("max_steps", true)
+	if err := wrongKind.Validate(d); err == nil {
+		t.Fatal("should error")
+	}
+}
+
+func TestParamDescrs(t *testing.T) {
+	config := NewConfig()
+	defer config.Close()
+	ctx := NewContext(config)
+	defer ctx.Close()
+
+	d := ctx.SimplifyParamDescrs()
+	defer d.Close()
+
+	names := d.Names()
+	if uint(len(names)) != d.Size() {
+		t.Fatalf("bad: %d %d", len(names), d.Size())
+	}
+
+	var found bool
+	for _, name := range names {
+		if name == "max_steps" {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatalf("bad: %v", names)
+	}
+
+	if v := d.Kind("max_steps"); v != ParamUint {
+		t.Fatalf("bad: %s", v)
+	}
+	if v := d.Kind("no_such_param"); v != ParamInvalid {
+		t.Fatalf("bad: %s", v)
+	}
+	if v := d.Doc("max_steps"); v == "" {
+		t.Fatal("should have docs")
+	}
+}
+
+func TestParamsSetString(t *testing.T) {
+	config := NewConfig()
+	defer config.Close()
+	ctx := NewContext(config)
+	defer ctx.Close()
+
+	s := ctx.NewSolver()
+	defer s.Close()
+
+	d := s.ParamDescrs()
+	defer d.Close()
+	if v := d.Kind("qi.cost"); v != ParamString {
+		t.Fatalf("bad: %s", v)
+	}
+
+	// The error is recorded right away and nothing is set
+	p := ctx.NewParams()
+	defer p.Close()
+	p.SetString("qi.cost", "(+ weight generation)")
+	err := ctx.Err()
+	if err == nil || !strings.Contains(err.Error(), `"qi.cost"`) {
+		t.Fatalf("bad: %v", err)
+	}
+	if zerr := err.(*Error); zerr.Code != ErrorCodeInvalidArg {
+		t.Fatalf("bad: %d", zerr.Code)
+	}
+	if v := p.String(); v != "(params)" {
+		t.Fatalf("bad: %s", v)
+	}
+	if err := s.SetParams(p); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
@@ -0,0 +1,157 @@
+package z3
+
+import (
+	"unsafe"
+)
+
+// #include <stdlib.h>
+// #include "go-z3.h"
+import "C"
+
+// Probe measures a characteristic of a goal, such as its number of
+// constants. Probes are used to choose tactics with Context.Cond,
+// Tactic.When and Context.FailIf.
+//
+// When a probe is no longer needed, the Close method must be called.
+type Probe struct {
+	rawCtx   C.Z3_context
+	rawProbe C.Z3_probe
+}
+
+// Probe creates the built-in probe with the given name, such as
+// "num-consts" or "is-qfbv". An error is returned if there is no probe
+// with that name.
+//
+// Maps: Z3_mk_probe
+func (c *Context) Probe(name string) (*Probe, error) {
+	cs := C.CString(name)
+	defer C.free(unsafe.Pointer(cs))
+
+	var raw C.Z3_probe
+	if err := withError(c.raw, func() {
+		raw = C.Z3_mk_probe(c.raw, cs)
+	}); err != nil {
+		return nil, err
+	}
+
+	return newProbe(c.raw, raw), nil
+}
+
+// Close frees the memory associated with this.
+func (p *Probe) Close() error {
+	C.Z3_probe_dec_ref(p.rawCtx, p.rawProbe)
+	return nil
+}
+
+// ProbeConst creates a probe that always evaluates to v. This is used to
+// compare other probes against constants.
+//
+// Maps: Z3_probe_const
+func (c *Context) ProbeConst(v float64) *Probe {
+	return newProbe(c.raw, C.Z3_probe_const(c.raw, C.double(v)))
+}
+
+// ProbeNames returns the names of all the built-in probes.
+//
+// Maps: Z3_get_probe_name
+func (c *Context) ProbeNames() []string {
+	result := make([]string, C.Z3_get_num_probes(c.raw))
+	for i := range result {
+		result[i] = C.GoString(C.Z3_get_probe_name(c.raw, C.uint(i)))
+	}
+
+	return result
+}
+
+// ProbeDescr returns a description of the built-in probe with the given
+// name.
+//
+// Maps: Z3_probe_get_descr
+func (c *Context) ProbeDescr(name string) string {
+	cs := C.CString(name)
+	defer C.free(unsafe.Pointer(cs))
+	return C.GoString(C.Z3_probe_get_descr(c.raw, cs))
+}
+
+// Apply evaluates the probe on the goal. Boolean probes, such as
+// "is-qfbv" or the result of a comparison, evaluate to 1 for true and 0
+// for false.
+//
+// Maps to: Z3_probe_apply
+func (p *Probe) Apply(g *Goal) float64 {
+	return float64(C.Z3_probe_apply(p.rawCtx, p.rawProbe, g.rawGoal))
+}
+
+//-------------------------------------------------------------------
+// Comparisons and Combinators
+//-------------------------------------------------------------------
+
+// Lt creates a probe that is true if the value of p is less than
+// the value of p2.
+//
+// Maps to: Z3_probe_lt
+func (p *Probe) Lt(p2 *Probe) *Probe {
+	return newProbe(p.rawCtx, C.Z3_probe_lt(p.rawCtx, p.rawProbe, p2.rawProbe))
+}
+
+// Gt creates a probe that is true if the value of p is greater than
+// the value of p2.
+//
+// Maps to: Z3_probe_gt
+func (p *Probe) Gt(p2 *Probe) *Probe {
+	return newProbe(p.rawCtx, C.Z3_probe_gt(p.rawCtx, p.rawProbe, p2.rawProbe))
+}
+
+// Le creates a probe that is true if the value of p is less than or equal to
+// the value of p2.
+//
+// Maps to: Z3_probe_le
+func (p *Probe) Le(p2 *Probe) *Probe {
+	return newProbe(p.rawCtx, C.Z3_probe_le(p.rawCtx, p.rawProbe, p2.rawProbe))
+}
+
+// Ge creates a probe that is true if the value of p is greater than or equal to
+// the value of p2.
+//
+// Maps to: Z3_probe_ge
+func (p *Probe) Ge(p2 *Probe) *Probe {
+	return newProbe(p.rawCtx, C.Z3_probe_ge(p.rawCtx, p.rawProbe, p2.rawProbe))
+}
+
+// Eq creates a probe that is true if the value of p is equal to
+// the value of p2.
+//
+// Maps to: Z3_probe_eq
+func (p *Probe) Eq(p2 *Probe) *Probe {
+	return newProbe(p.rawCtx, C.Z3_probe_eq(p.rawCtx, p.rawProbe, p2.rawProbe))
+}
+
+// And creates a probe that is true if both p and p2 are true.
+//
+// Maps to: Z3_probe_and
+func (p *Probe) And(p2 *Probe) *Probe {
+	return newProbe(p.rawCtx, C.Z3_probe_and(p.rawCtx, p.rawProbe, p2.rawProbe))
+}
+
+// Or creates a probe that is true if p or p2 is true.
+//
+// Maps to: Z3_probe_or
+func (p *Probe) Or(p2 *Probe) *Probe {
+	return newProbe(p.rawCtx, C.Z3_probe_or(p.rawCtx, p.rawProbe, p2.rawProbe))
+}
+
+// Not creates a probe that is true if p is false.
+//
+// Maps to: Z3_probe_not
+func (p *Probe) Not() *Probe {
+	return newProbe(p.rawCtx, C.Z3_probe_not(p.rawCtx, p.rawProbe))
+}
+
+// newProbe wraps a raw probe, taking a reference to it.
+func newProbe(rawCtx C.Z3_context, raw C.Z3_probe) *Probe {
+	C.Z3_probe_inc_ref(rawCtx, raw)
+	return &Probe{
+		rawCtx:   rawCtx,
+		rawProbe: raw,
+	}
+}
@@ -0,0 +1,93 @@
+package z3
+
+import (
+	"testing"
+)
+
+func TestContextProbe(t *testing.T) {
+	config := NewConfig()
+	defer config.Close()
+	ctx := NewContext(config)
+	defer ctx.Close()
+
+	// The built-in probes are listed with descriptions
+	var found bool
+	for _, name := range ctx.ProbeNames() {
+		if name == "num-consts" {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatalf("bad: %v", ctx.ProbeNames())
+	}
+	if v := ctx.ProbeDescr("num-consts"); v == "" {
+		t.Fatal("should have a description")
+	}
+
+	// Unknown probes are errors
+	if _, err := ctx.Probe("no-such-probe"); err == nil {
+		t.Fatal("should error")
+	}
+}
+
+func TestProbeApply(t *testing.T) {
+	config := NewConfig()
+	defer config.Close()
+	ctx := NewContext(config)
+	defer ctx.Close()
+
+	// A bit-vector goal with three constants
+	g := ctx.NewGoal(true, false, false)
+	defer g.Close()
+	for _, name := range []string{"x", "y", "z"} {
+		v := ctx.Const(ctx.Symbol(name), ctx.BitVecSort(8))
+		g.Assert(v.BVUGT(ctx.BitVec(3, 8)))
+	}
+
+	numConsts, err := ctx.Probe("num-consts")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer numConsts.Close()
+	isQFBV, err := ctx.Probe("is-qfbv")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer isQFBV.Close()
+	isQFLIA, err := ctx.Probe("is-qflia")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer isQFLIA.Close()
+
+	if v := numConsts.Apply(g); v != 3 {
+		t.Fatalf("bad: %v", v)
+	}
+	if v := isQFBV.Apply(g); v != 1 {
+		t.Fatalf("bad: %v", v)
+	}
+
+	three := ctx.ProbeConst(3)
+	defer three.Close()
+	cases := []struct {
+		Name  string
+		Probe *Probe
+		Want  float64
+	}{
+		{"lt", numConsts.Lt(three), 0},
+		{"gt", numConsts.Gt(three), 0},
+		{"le", numConsts.Le(three), 1},
+		{"ge", numConsts.Ge(three), 1},
+		{"eq", numConsts.Eq(three), 1},
+		{"and", isQFBV.And(isQFLIA), 0},
+		{"or", isQFBV.Or(isQFLIA), 1},
+		{"not", isQFLIA.Not(), 1},
+	}
+
+	for _, tc := range cases {
+		defer tc.Probe.Close()
+		if v := tc.Probe.Apply(g); v != tc.Want {
+			t.Fatalf("%s: bad: %v", tc.Name, v)
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package z3
+
+// #include "go-z3.h"
+import "C"
+
+// Simplify simplifies the AST using Z3's rewriter with the default
+// parameters. The result is equivalent to a and is useful as a
+// canonical form, for example to cache formulas.
+//
+// Maps to: Z3_simplify
+func (a *AST) Simplify() *AST {
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: C.Z3_simplify(a.rawCtx, a.rawAST),
+	}
+}
+
+// SimplifyWithParams is like Simplify but configures the rewriter with
+// the given parameters, such as "som" to put polynomials into sum of
+// monomials form. The parameters are checked against
+// Context.SimplifyParamDescrs first, so an error is returned if a name
+// is misspelled or a value has the wrong kind.
+//
+// Maps to: Z3_simplify_ex
+func (a *AST) SimplifyWithParams(p *Params) (*AST, error) {
+	d := newParamDescrs(a.rawCtx, C.Z3_simplify_get_param_descrs(a.rawCtx))
+	defer d.Close()
+	if err := p.Validate(d); err != nil {
+		return nil, err
+	}
+
+	var raw C.Z3_ast
+	if err := withError(a.rawCtx, func() {
+		raw = C.Z3_simplify_ex(a.rawCtx, a.rawAST, p.rawParams)
+	}); err != nil {
+		return nil, err
+	}
+
+	return &AST{
+		rawCtx: a.rawCtx,
+		rawAST: raw,
+	}, nil
+}
+
+// SimplifyHelp returns a description of the parameters the simplifier
+// accepts. Use SimplifyParamDescrs to list them programmatically.
+//
+// Maps: Z3_simplify_get_help
+func (c *Context) SimplifyHelp() string {
+	return C.GoString(C.Z3_simplify_get_help(c.raw))
+}
+
+// SimplifyParamDescrs returns the descriptions of the parameters the
+// simplifier accepts.
+//
+// Maps: Z3_simplify_get_param_descrs
+func (c *Context) SimplifyParamDescrs() *ParamDescrs {
+	return newParamDescrs(c.raw, C.Z3_simplify_get_param_descrs(c.raw))
+}
@@ -0,0 +1,73 @@
+package z3
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestASTSimplify(t *testing.T) {
+	config := NewConfig()
+	defer config.Close()
+	ctx := NewContext(config)
+	defer ctx.Close()
+
+	// (b + 1) + (b + 2)
+	b := ctx.Const(ctx.Symbol("b"), ctx.BitVecSort(8))
+	raw := b.BVAdd(ctx.BitVec(1, 8)).BVAdd(b.BVAdd(ctx.BitVec(2, 8)))
+
+	actual := raw.Simplify().String()
+	if actual != "(bvadd #x03 (bvmul #x02 b))" {
+		t.Fatalf("bad:\n%s", actual)
+	}
+
+	// Equivalent terms simplify to the same node
+	other := b.BVMul(ctx.BitVec(2, 8)).BVAdd(ctx.BitVec(3, 8))
+	if raw.Simplify().ID() != other.Simplify().ID() {
+		t.Fatalf("bad: %s", other.Simplify())
+	}
+}
+
+func TestASTSimplifyWithParams(t *testing.T) {
+	config := NewConfig()
+	defer config.Close()
+	ctx := NewContext(config)
+	defer ctx.Close()
+
+	// (x + y) * (x - y)
+	x := ctx.Const(ctx.Symbol("x"), ctx.IntSort())
+	y := ctx.Const(ctx.Symbol("y"), ctx.IntSort())
+	raw := x.Add(y).Mul(x.Sub(y))
+
+	// Sum of monomials
+	p := ctx.NewParams()
+	defer p.Close()
+	p.SetBool("som", true)
+	v, err := raw.SimplifyWithParams(p)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	actual := v.String()
+	if actual != "(+ (* x x) (* (- 1) y y))" {
+		t.Fatalf("bad:\n%s", actual)
+	}
+
+	// Misspelled parameters are errors
+	misspelled := ctx.NewParams()
+	defer misspelled.Close()
+	misspelled.SetBool("smo", true)
+	if _, err := raw.SimplifyWithParams(misspelled); err == nil {
+		t.Fatal("should error")
+	}
+}
+
+func TestContextSimplifyHelp(t *testing.T) {
+	config := NewConfig()
+	defer config.Close()
+	ctx := NewContext(config)
+	defer ctx.Close()
+
+	if v := ctx.SimplifyHelp(); !strings.Contains(v, "som") {
+		t.Fatalf("bad: %s", v)
+	}
+}
@@ -0,0 +1,214 @@
+package z3
+
+import (
+	"io"
+	"time"
+	"unsafe"
+)
+
+// #include <stdlib.h>
+// #include "go-z3.h"
+import "C"
+
+// ParseSMTLIB2String parses a string in the SMT-LIB2 format and returns
+// the assertions it contains.
+//
+// sorts and decls may be used to make sorts and declarations created in
+// Go available to the parsed input by name. They may be nil. Any
+// declarations within the input itself are also honored.
+//
+// Parse errors are returned as an *Error with the ErrorCodeParserError
+// code. The message includes the line and column of the error.
+//
+// Maps: Z3_parse_smtlib2_string
+func (c *Context) ParseSMTLIB2String(
+	s string, sorts map[string]*Sort, decls map[string]*FuncDecl) ([]*AST, error) {
+	cs := C.CString(s)
+	defer C.free(unsafe.Pointer(cs))
+
+	env := c.newSMTLIB2Env(sorts, decls)
+	var raw C.Z3_ast_vector
+	if err := withError(c.raw, func() {
+		raw = C.Z3_parse_smtlib2_string(
+			c.raw, cs,
+			env.numSorts(), env.sortNames(), env.sorts(),
+			env.numDecls(), env.declNames(), env.decls())
+	}); err != nil {
+		return nil, err
+	}
+
+	return astVectorToSlice(c.raw, raw), nil
+}
+
+// ParseSMTLIB2File is like ParseSMTLIB2String but reads the input from
+// the file at the given path.
+//
+// Maps: Z3_parse_smtlib2_file
+func (c *Context) ParseSMTLIB2File(
+	path string, sorts map[string]*Sort, decls map[string]*FuncDecl) ([]*AST, error) {
+	cpath := C.CString(path)
+	defer C.free(unsafe.Pointer(cpath))
+
+	env := c.newSMTLIB2Env(sorts, decls)
+	var raw C.Z3_ast_vector
+	if err := withError(c.raw, func() {
+		raw = C.Z3_parse_smtlib2_file(
+			c.raw, cpath,
+			env.numSorts(), env.sortNames(), env.sorts(),
+			env.numDecls(), env.declNames(), env.decls())
+	}); err != nil {
+		return nil, err
+	}
+
+	return astVectorToSlice(c.raw, raw), nil
+}
+
+// FromString parses a string in the SMT-LIB2 format and asserts its
+// assertions onto the solver. Parse errors are returned the same way as
+// with Context.ParseSMTLIB2String.
+//
+// Maps to: Z3_solver_from_string
+func (s *Solver) FromString(v string) error {
+	cs := C.CString(v)
+	defer C.free(unsafe.Pointer(cs))
+
+	return withError(s.rawCtx, func() {
+		C.Z3_solver_from_string(s.rawCtx, s.rawSolver, cs)
+	})
+}
+
+// FromFile is like FromString but reads the input from the file at the
+// given path.
+//
+// Maps to: Z3_solver_from_file
+func (s *Solver) FromFile(path string) error {
+	cpath := C.CString(path)
+	defer C.free(unsafe.Pointer(cpath))
+
+	return withError(s.rawCtx, func() {
+		C.Z3_solver_from_file(s.rawCtx, s.rawSolver, cpath)
+	})
+}
+
+// ToSMTLIB2 returns the solver's assertions as an SMT-LIB2 benchmark,
+// including the declarations they need and a final (check-sat). The
+// result can be run as-is by the z3 command line tool to reproduce a
+// query.
+//
+// Maps to: Z3_benchmark_to_smtlib_string
+func (s *Solver) ToSMTLIB2() string {
+	return s.benchmark(s.Assertions())
+}
+
+// benchmark returns the given assertions as an SMT-LIB2 benchmark in the
+// format of ToSMTLIB2.
+func (s *Solver) benchmark(assertions []*AST) string {
+	raws := make([]C.Z3_ast, len(assertions))
+	for i, a := range assertions {
+		raws[i] = a.rawAST
+	}
+
+	var rawAssertions *C.Z3_ast
+	if len(raws) > 0 {
+		rawAssertions = (*C.Z3_ast)(unsafe.Pointer(&raws[0]))
+	}
+
+	empty := C.CString("")
+	defer C.free(unsafe.Pointer(empty))
+	status := C.CString("unknown")
+	defer C.free(unsafe.Pointer(status))
+
+	// The assertions are passed as assumptions with a trivial formula
+	// since Z3 omits the formula from the output when it is "true".
+	return C.GoString(C.Z3_benchmark_to_smtlib_string(
+		s.rawCtx, empty, empty, status, empty,
+		C.uint(len(raws)), rawAssertions, C.Z3_mk_true(s.rawCtx)))
+}
+
+// SetSlowCheckDump configures the solver to write the ToSMTLIB2 output
+// to w whenever a Check, CheckAssumptions or CheckContext call takes
+// longer than threshold. This is useful for capturing hard queries to
+// attach to bug reports. The assumptions of a CheckAssumptions call are
+// included as additional assertions. Errors writing to w are ignored.
+//
+// A nil w disables this behavior, which is the default.
+func (s *Solver) SetSlowCheckDump(threshold time.Duration, w io.Writer) {
+	s.slowThreshold = threshold
+	s.slowWriter = w
+}
+
+// dumpIfSlow writes the solver to the slow check writer if the check
+// that started at the given time exceeded the threshold. Any assumptions
+// the check was made with are dumped along with the assertions.
+func (s *Solver) dumpIfSlow(start time.Time, assumptions []*AST) {
+	if s.slowWriter == nil || time.Since(start) <= s.slowThreshold {
+		return
+	}
+
+	assertions := append(s.Assertions(), assumptions...)
+	io.WriteString(s.slowWriter, s.benchmark(assertions))
+}
+
+// smtlib2Env holds the raw sort and declaration environment passed to
+// the Z3 SMT-LIB2 parser.
+type smtlib2Env struct {
+	rawSortNames []C.Z3_symbol
+	rawSorts     []C.Z3_sort
+	rawDeclNames []C.Z3_symbol
+	rawDecls     []C.Z3_func_decl
+}
+
+func (c *Context) newSMTLIB2Env(
+	sorts map[string]*Sort, decls map[string]*FuncDecl) *smtlib2Env {
+	var env smtlib2Env
+	for name, s := range sorts {
+		env.rawSortNames = append(env.rawSortNames, c.Symbol(name).rawSymbol)
+		env.rawSorts = append(env.rawSorts, s.rawSort)
+	}
+	for name, d := range decls {
+		env.rawDeclNames = append(env.rawDeclNames, c.Symbol(name).rawSymbol)
+		env.rawDecls = append(env.rawDecls, d.rawFuncDecl)
+	}
+
+	return &env
+}
+
+func (e *smtlib2Env) numSorts() C.uint {
+	return C.uint(len(e.rawSorts))
+}
+
+func (e *smtlib2Env) sortNames() *C.Z3_symbol {
+	if len(e.rawSortNames) == 0 {
+		return nil
+	}
+
+	return (*C.Z3_symbol)(unsafe.Pointer(&e.rawSortNames[0]))
+}
+
+func (e *smtlib2Env) sorts() *C.Z3_sort {
+	if len(e.rawSorts) == 0 {
+		return nil
+	}
+
+	return (*C.Z3_sort)(unsafe.Pointer(&e.rawSorts[0]))
+}
+
+func (e *smtlib2Env) numDecls() C.uint {
+	return C.uint(len(e.rawDecls))
+}
+
+func (e *smtlib2Env) declNames() *C.Z3_symbol {
+	if len(e.rawDeclNames) == 0 {
+		return nil
+	}
+
+	return (*C.Z3_symbol)(unsafe.Pointer(&e.rawDeclNames[0]))
+}
+
+func (e *smtlib2Env) decls() *C.Z3_func_decl {
+	if len(e.rawDecls) == 0 {
+		return nil
+	}
+
+	return (*C.Z3_func_decl)(unsafe.Pointer(&e.rawDecls[0]))
+}
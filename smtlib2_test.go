@@ -0,0 +1,217 @@
+package z3
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testSMTLIB2 = `
+(declare-const x Int)
+(declare-const y Int)
+(assert (> x y))
+(assert (= (+ x y) 10))
+`
+
+func TestParseSMTLIB2String(t *testing.T) {
+	config := NewConfig()
+	defer config.Close()
+	ctx := NewContext(config)
+	defer ctx.Close()
+
+	asts, err := ctx.ParseSMTLIB2String(testSMTLIB2, nil, nil)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if len(asts) != 2 {
+		t.Fatalf("bad: %v", asts)
+	}
+	if v := asts[0].String(); v != "(> x y)" {
+		t.Fatalf("bad: %s", v)
+	}
+}
+
+func TestParseSMTLIB2String_env(t *testing.T) {
+	config := NewConfig()
+	defer config.Close()
+	ctx := NewContext(config)
+	defer ctx.Close()
+
+	// Make a sort and function from Go available to the input
+	f := ctx.FuncDecl(ctx.Symbol("f"), []*Sort{ctx.IntSort()}, ctx.BoolSort())
+	asts, err := ctx.ParseSMTLIB2String(
+		"(declare-const v Word) (assert (f (bv2nat v)))",
+		map[string]*Sort{"Word": ctx.BitVecSort(16)},
+		map[string]*FuncDecl{"f": f})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if len(asts) != 1 || asts[0].String() != "(f (bv2int v))" {
+		t.Fatalf("bad: %v", asts)
+	}
+}
+
+func TestParseSMTLIB2String_error(t *testing.T) {
+	config := NewConfig()
+	defer config.Close()
+	ctx := NewContext(config)
+	defer ctx.Close()
+
+	_, err := ctx.ParseSMTLIB2String("(assert (> x 1))", nil, nil)
+	if err == nil {
+		t.Fatal("should error")
+	}
+	zerr, ok := err.(*Error)
+	if !ok {
+		t.Fatalf("bad: %#v", err)
+	}
+	if zerr.Code != ErrorCodeParserError {
+		t.Fatalf("bad: %d", zerr.Code)
+	}
+	if !strings.Contains(zerr.Message, "line 1") {
+		t.Fatalf("bad: %s", zerr.Message)
+	}
+}
+
+func TestParseSMTLIB2File(t *testing.T) {
+	config := NewConfig()
+	defer config.Close()
+	ctx := NewContext(config)
+	defer ctx.Close()
+
+	td, err := ioutil.TempDir("", "go-z3")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer os.RemoveAll(td)
+
+	path := filepath.Join(td, "test.smt2")
+	if err := ioutil.WriteFile(path, []byte(testSMTLIB2), 0644); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	asts, err := ctx.ParseSMTLIB2File(path, nil, nil)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if len(asts) != 2 {
+		t.Fatalf("bad: %v", asts)
+	}
+
+	// Solvers can load the file directly
+	s := ctx.NewSolver()
+	defer s.Close()
+	if err := s.FromFile(path); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if v := len(s.Assertions()); v != 2 {
+		t.Fatalf("bad: %d", v)
+	}
+}
+
+func TestSolverFromString(t *testing.T) {
+	config := NewConfig()
+	defer config.Close()
+	ctx := NewContext(config)
+	defer ctx.Close()
+
+	s := ctx.NewSolver()
+	defer s.Close()
+
+	if err := s.FromString(testSMTLIB2); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if v := s.Check(); v != True {
+		t.Fatalf("bad: %v", v)
+	}
+
+	// Errors are reported
+	if err := s.FromString("(assert"); err == nil {
+		t.Fatal("should error")
+	}
+}
+
+func TestSolverToSMTLIB2(t *testing.T) {
+	config := NewConfig()
+	defer config.Close()
+	ctx := NewContext(config)
+	defer ctx.Close()
+
+	s := ctx.NewSolver()
+	defer s.Close()
+
+	x := ctx.Const(ctx.Symbol("x"), ctx.IntSort())
+	s.Assert(x.Gt(ctx.Int(1, ctx.IntSort())))
+	s.Assert(x.Lt(ctx.Int(3, ctx.IntSort())))
+
+	actual := s.ToSMTLIB2()
+	for _, expected := range []string{
+		"(declare-fun x () Int)",
+		"(> x 1)",
+		"(< x 3)",
+		"(check-sat)",
+	} {
+		if !strings.Contains(actual, expected) {
+			t.Fatalf("missing %q:\n%s", expected, actual)
+		}
+	}
+
+	// The output round trips through the parser
+	s2 := ctx.NewSolver()
+	defer s2.Close()
+	if err := s2.FromString(actual); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if v := len(s2.Assertions()); v != 2 {
+		t.Fatalf("bad: %d", v)
+	}
+}
+
+func TestSolverSetSlowCheckDump(t *testing.T) {
+	config := NewConfig()
+	defer config.Close()
+	ctx := NewContext(config)
+	defer ctx.Close()
+
+	s := ctx.NewSolver()
+	defer s.Close()
+
+	x := ctx.Const(ctx.Symbol("x"), ctx.IntSort())
+	s.Assert(x.Gt(ctx.Int(1, ctx.IntSort())))
+
+	// Fast checks aren't dumped
+	var buf bytes.Buffer
+	s.SetSlowCheckDump(time.Hour, &buf)
+	s.Check()
+	if buf.Len() != 0 {
+		t.Fatalf("bad: %s", buf.String())
+	}
+
+	// Every check exceeds a negative threshold
+	s.SetSlowCheckDump(-1, &buf)
+	s.Check()
+	if !strings.Contains(buf.String(), "(check-sat)") {
+		t.Fatalf("bad: %s", buf.String())
+	}
+
+	// Assumptions are dumped along with the assertions
+	buf.Reset()
+	p := ctx.Const(ctx.Symbol("p"), ctx.BoolSort())
+	s.CheckAssumptions(p)
+	if !strings.Contains(buf.String(), "(declare-fun p () Bool)") ||
+		!strings.Contains(buf.String(), "(assert\n p)") {
+		t.Fatalf("bad: %s", buf.String())
+	}
+
+	// Disabled
+	buf.Reset()
+	s.SetSlowCheckDump(-1, nil)
+	s.Check()
+	if buf.Len() != 0 {
+		t.Fatalf("bad: %s", buf.String())
+	}
+}
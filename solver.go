@@ -1,5 +1,13 @@
 package z3
 
+import (
+	"context"
+	"io"
+	"strings"
+	"time"
+	"unsafe"
+)
+
 // #include "go-z3.h"
 import "C"
 
@@ -15,6 +23,10 @@ import "C"
 type Solver struct {
 	rawCtx    C.Z3_context
 	rawSolver C.Z3_solver
+
+	// Set by SetSlowCheckDump
+	slowThreshold time.Duration
+	slowWriter    io.Writer
 }
 
 // NewSolver creates a new solver.
@@ -28,12 +40,54 @@ func (c *Context) NewSolver() *Solver {
 	}
 }
 
+// NewSolverFromTactic creates a new solver that checks its assertions
+// by applying the tactic t. The solver returns Undef if t doesn't decide
+// the assertions, that is if it doesn't reduce them to a single empty
+// goal or a goal containing false.
+//
+// Maps: Z3_mk_solver_from_tactic
+func (c *Context) NewSolverFromTactic(t *Tactic) *Solver {
+	rawSolver := C.Z3_mk_solver_from_tactic(c.raw, t.rawTactic)
+	C.Z3_solver_inc_ref(c.raw, rawSolver)
+
+	return &Solver{
+		rawSolver: rawSolver,
+		rawCtx:    c.raw,
+	}
+}
+
 // Close frees the memory associated with this.
 func (s *Solver) Close() error {
 	C.Z3_solver_dec_ref(s.rawCtx, s.rawSolver)
 	return nil
 }
 
+// SetParams sets parameters on the solver, such as "timeout". The
+// parameters are checked against ParamDescrs first, so an error is
+// returned and nothing is set if a name is misspelled or a value has the
+// wrong kind.
+//
+// Maps to: Z3_solver_set_params
+func (s *Solver) SetParams(p *Params) error {
+	d := s.ParamDescrs()
+	defer d.Close()
+	if err := p.Validate(d); err != nil {
+		return err
+	}
+
+	return withError(s.rawCtx, func() {
+		C.Z3_solver_set_params(s.rawCtx, s.rawSolver, p.rawParams)
+	})
+}
+
+// ParamDescrs returns the descriptions of the parameters the solver
+// accepts.
+//
+// Maps to: Z3_solver_get_param_descrs
+func (s *Solver) ParamDescrs() *ParamDescrs {
+	return newParamDescrs(s.rawCtx, C.Z3_solver_get_param_descrs(s.rawCtx, s.rawSolver))
+}
+
 // Assert asserts a constraint onto the Solver.
 //
 // Maps to: Z3_solver_assert
@@ -45,9 +99,115 @@ func (s *Solver) Assert(a *AST) {
 //
 // Maps to: Z3_solver_check
 func (s *Solver) Check() LBool {
+	defer s.dumpIfSlow(time.Now(), nil)
 	return LBool(C.Z3_solver_check(s.rawCtx, s.rawSolver))
 }
 
+// CheckContext is like Check but stops early if the given context is
+// cancelled or its deadline elapses. In that case the solver is
+// interrupted and the result is Undef along with the error from the
+// context (context.Canceled or context.DeadlineExceeded).
+//
+// Interrupting uses Context.Interrupt, so any other operation running in
+// the same Z3 Context at that moment is interrupted as well.
+func (s *Solver) CheckContext(ctx context.Context) (LBool, error) {
+	if err := ctx.Err(); err != nil {
+		return Undef, err
+	}
+
+	// Watch the context while checking. We wait for the watcher to exit
+	// before returning so it can't interrupt anything after this check.
+	doneCh := make(chan struct{})
+	stoppedCh := make(chan struct{})
+	go func() {
+		defer close(stoppedCh)
+		select {
+		case <-ctx.Done():
+			C.Z3_interrupt(s.rawCtx)
+		case <-doneCh:
+		}
+	}()
+
+	result := s.Check()
+	close(doneCh)
+	<-stoppedCh
+
+	if result == Undef {
+		if err := ctx.Err(); err != nil {
+			return Undef, err
+		}
+	}
+
+	return result, nil
+}
+
+// AssertAndTrack asserts a constraint onto the Solver and tracks it with
+// the label p, which must be a boolean constant. If the solver finds the
+// assertions unsatisfiable, p will be included in UnsatCore when a is
+// part of the reason.
+//
+// Maps to: Z3_solver_assert_and_track
+func (s *Solver) AssertAndTrack(a, p *AST) {
+	C.Z3_solver_assert_and_track(s.rawCtx, s.rawSolver, a.rawAST, p.rawAST)
+}
+
+// CheckAssumptions checks if the currently set formula is consistent
+// when the given assumptions, which must be boolean constants or their
+// negations, are also true. The assumptions aren't added to the solver.
+//
+// If the result is False, UnsatCore returns the assumptions that were
+// used to show the formula inconsistent.
+//
+// Maps to: Z3_solver_check_assumptions
+func (s *Solver) CheckAssumptions(assumptions ...*AST) LBool {
+	raws := make([]C.Z3_ast, len(assumptions))
+	for i, a := range assumptions {
+		raws[i] = a.rawAST
+	}
+
+	var rawAssumptions *C.Z3_ast
+	if len(raws) > 0 {
+		rawAssumptions = (*C.Z3_ast)(unsafe.Pointer(&raws[0]))
+	}
+
+	defer s.dumpIfSlow(time.Now(), assumptions)
+	return LBool(C.Z3_solver_check_assumptions(
+		s.rawCtx, s.rawSolver, C.uint(len(raws)), rawAssumptions))
+}
+
+// UnsatCore returns the subset of the assumptions and tracked labels
+// that were used to show the formula inconsistent in the last Check or
+// CheckAssumptions. This is only meaningful if the last result was
+// False.
+//
+// Core generation is enabled automatically by AssertAndTrack and
+// CheckAssumptions. It can also be enabled for all solvers with the
+// "unsat_core" Config parameter.
+//
+// Maps to: Z3_solver_get_unsat_core
+func (s *Solver) UnsatCore() []*AST {
+	return astVectorToSlice(
+		s.rawCtx, C.Z3_solver_get_unsat_core(s.rawCtx, s.rawSolver))
+}
+
+// ReasonUnknown returns a description of why the last Check returned
+// Undef. See ReasonUnknownKind for a classification of this value.
+//
+// Maps to: Z3_solver_get_reason_unknown
+func (s *Solver) ReasonUnknown() string {
+	return C.GoString(C.Z3_solver_get_reason_unknown(s.rawCtx, s.rawSolver))
+}
+
+// ReasonUnknownKind classifies ReasonUnknown so that callers can decide
+// how to react to an Undef result, such as retrying with a longer
+// timeout.
+//
+// This doesn't map to any specific Z3 API. Z3 only exposes the reason as
+// a string, so the classification is based on its contents.
+func (s *Solver) ReasonUnknownKind() UnknownKind {
+	return classifyReasonUnknown(s.ReasonUnknown())
+}
+
 // Model returns the last model from a Check.
 //
 // Maps to: Z3_solver_get_model
@@ -59,3 +219,102 @@ func (s *Solver) Model() *Model {
 	m.IncRef()
 	return m
 }
+
+// Push creates a backtracking point. All assertions made after the call
+// to Push are removed by the matching call to Pop.
+//
+// Maps to: Z3_solver_push
+func (s *Solver) Push() {
+	C.Z3_solver_push(s.rawCtx, s.rawSolver)
+}
+
+// Pop removes n backtracking points created by Push, along with all
+// assertions made since then. n must not be larger than NumScopes.
+//
+// Maps to: Z3_solver_pop
+func (s *Solver) Pop(n uint) {
+	C.Z3_solver_pop(s.rawCtx, s.rawSolver, C.uint(n))
+}
+
+// NumScopes returns the number of backtracking points created by Push
+// that haven't been removed by Pop.
+//
+// Maps to: Z3_solver_get_num_scopes
+func (s *Solver) NumScopes() uint {
+	return uint(C.Z3_solver_get_num_scopes(s.rawCtx, s.rawSolver))
+}
+
+// Reset removes all assertions and backtracking points from the solver.
+//
+// Maps to: Z3_solver_reset
+func (s *Solver) Reset() {
+	C.Z3_solver_reset(s.rawCtx, s.rawSolver)
+}
+
+// Assertions returns the assertions currently in the solver.
+//
+// Maps to: Z3_solver_get_assertions
+func (s *Solver) Assertions() []*AST {
+	return astVectorToSlice(
+		s.rawCtx, C.Z3_solver_get_assertions(s.rawCtx, s.rawSolver))
+}
+
+// UnknownKind classifies the reason a solver returned Undef.
+type UnknownKind uint8
+
+const (
+	// UnknownOther is any reason not covered by the other kinds.
+	UnknownOther UnknownKind = iota
+
+	// UnknownTimeout means a time or resource limit was reached. Raising
+	// the limit may produce a result.
+	UnknownTimeout
+
+	// UnknownCanceled means the check was interrupted, for example with
+	// Context.Interrupt or by Solver.CheckContext.
+	UnknownCanceled
+
+	// UnknownIncomplete means the solver gave up because its procedures
+	// are incomplete for the formula, for example with quantifiers or
+	// non-linear arithmetic. Raising limits is unlikely to help.
+	UnknownIncomplete
+
+	// UnknownMemoryLimit means the memory limit was reached.
+	UnknownMemoryLimit
+)
+
+func (k UnknownKind) String() string {
+	switch k {
+	case UnknownTimeout:
+		return "timeout"
+	case UnknownCanceled:
+		return "canceled"
+	case UnknownIncomplete:
+		return "incomplete"
+	case UnknownMemoryLimit:
+		return "memory limit"
+	default:
+		return "other"
+	}
+}
+
+// classifyReasonUnknown maps the reason strings produced by Z3 onto an
+// UnknownKind.
+func classifyReasonUnknown(reason string) UnknownKind {
+	reason = strings.ToLower(reason)
+	switch {
+	case strings.Contains(reason, "cancel"),
+		strings.Contains(reason, "interrupt"):
+		return UnknownCanceled
+	case strings.Contains(reason, "timeout"),
+		strings.Contains(reason, "resource limit"):
+		return UnknownTimeout
+	case strings.Contains(reason, "memory"),
+		strings.Contains(reason, "memout"):
+		return UnknownMemoryLimit
+	case strings.Contains(reason, "incomplete"):
+		return UnknownIncomplete
+	default:
+		return UnknownOther
+	}
+}
@@ -1,7 +1,9 @@
 package z3
 
 import (
+	"context"
 	"testing"
+	"time"
 )
 
 func TestSolver(t *testing.T) {
@@ -37,3 +39,259 @@ func TestSolver(t *testing.T) {
 	defer m.Close()
 	t.Logf("\nModel:\n%s", m.String())
 }
+
+func TestSolverPushPop(t *testing.T) {
+	config := NewConfig()
+	defer config.Close()
+
+	ctx := NewContext(config)
+	defer ctx.Close()
+
+	// Create the solver
+	s := ctx.NewSolver()
+	defer s.Close()
+
+	// x > 0
+	x := ctx.Const(ctx.Symbol("x"), ctx.IntSort())
+	zero := ctx.Int(0, ctx.IntSort())
+	s.Assert(x.Gt(zero))
+
+	// Push and make it unsatisfiable with x < 0
+	s.Push()
+	s.Assert(x.Lt(zero))
+	if v := s.NumScopes(); v != 1 {
+		t.Fatalf("bad: %d", v)
+	}
+	if v := len(s.Assertions()); v != 2 {
+		t.Fatalf("bad: %d", v)
+	}
+	if v := s.Check(); v != False {
+		t.Fatalf("bad: %v", v)
+	}
+
+	// Pop back to the satisfiable state
+	s.Pop(1)
+	if v := s.NumScopes(); v != 0 {
+		t.Fatalf("bad: %d", v)
+	}
+	assertions := s.Assertions()
+	if len(assertions) != 1 || assertions[0].String() != "(> x 0)" {
+		t.Fatalf("bad: %v", assertions)
+	}
+	if v := s.Check(); v != True {
+		t.Fatalf("bad: %v", v)
+	}
+
+	// Reset removes everything
+	s.Push()
+	s.Reset()
+	if v := s.NumScopes(); v != 0 {
+		t.Fatalf("bad: %d", v)
+	}
+	if v := len(s.Assertions()); v != 0 {
+		t.Fatalf("bad: %d", v)
+	}
+}
+
+func TestSolverUnsatCore(t *testing.T) {
+	config := NewConfig()
+	defer config.Close()
+
+	ctx := NewContext(config)
+	defer ctx.Close()
+
+	// Create the solver
+	s := ctx.NewSolver()
+	defer s.Close()
+
+	x := ctx.Const(ctx.Symbol("x"), ctx.IntSort())
+	zero := ctx.Int(0, ctx.IntSort())
+	ten := ctx.Int(10, ctx.IntSort())
+
+	// Track three rules, two of which conflict
+	r1 := ctx.Const(ctx.Symbol("r1"), ctx.BoolSort())
+	r2 := ctx.Const(ctx.Symbol("r2"), ctx.BoolSort())
+	r3 := ctx.Const(ctx.Symbol("r3"), ctx.BoolSort())
+	s.AssertAndTrack(x.Gt(ten), r1)
+	s.AssertAndTrack(x.Lt(zero), r2)
+	s.AssertAndTrack(x.Eq(zero).Not(), r3)
+
+	if v := s.Check(); v != False {
+		t.Fatalf("bad: %v", v)
+	}
+
+	core := make(map[string]bool)
+	for _, c := range s.UnsatCore() {
+		core[c.String()] = true
+	}
+	if len(core) != 2 || !core["r1"] || !core["r2"] {
+		t.Fatalf("bad: %v", core)
+	}
+}
+
+func TestSolverCheckAssumptions(t *testing.T) {
+	config := NewConfig()
+	defer config.Close()
+
+	ctx := NewContext(config)
+	defer ctx.Close()
+
+	// Create the solver
+	s := ctx.NewSolver()
+	defer s.Close()
+
+	// p implies q
+	p := ctx.Const(ctx.Symbol("p"), ctx.BoolSort())
+	q := ctx.Const(ctx.Symbol("q"), ctx.BoolSort())
+	s.Assert(p.Implies(q))
+
+	// Assuming p and not q is inconsistent
+	if v := s.CheckAssumptions(p, q.Not()); v != False {
+		t.Fatalf("bad: %v", v)
+	}
+	if v := len(s.UnsatCore()); v != 2 {
+		t.Fatalf("bad: %d", v)
+	}
+
+	// The assumptions don't stick
+	if v := s.CheckAssumptions(p); v != True {
+		t.Fatalf("bad: %v", v)
+	}
+	if v := s.Check(); v != True {
+		t.Fatalf("bad: %v", v)
+	}
+}
+
+func TestSolverCheckContext(t *testing.T) {
+	config := NewConfig()
+	defer config.Close()
+
+	ctx := NewContext(config)
+	defer ctx.Close()
+
+	// Create the solver
+	s := ctx.NewSolver()
+	defer s.Close()
+
+	// x^3 + y^3 = z^3 has no positive solutions but Z3 can't show it,
+	// so this runs until it is interrupted.
+	x := ctx.Const(ctx.Symbol("x"), ctx.IntSort())
+	y := ctx.Const(ctx.Symbol("y"), ctx.IntSort())
+	z := ctx.Const(ctx.Symbol("z"), ctx.IntSort())
+	zero := ctx.Int(0, ctx.IntSort())
+	s.Assert(x.Gt(zero))
+	s.Assert(y.Gt(zero))
+	s.Assert(z.Gt(zero))
+	s.Assert(x.Mul(x, x).Add(y.Mul(y, y)).Eq(z.Mul(z, z)))
+
+	gctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	v, err := s.CheckContext(gctx)
+	if v != Undef {
+		t.Fatalf("bad: %v", v)
+	}
+	if err != context.DeadlineExceeded {
+		t.Fatalf("bad: %v", err)
+	}
+	if d := time.Since(start); d > 5*time.Second {
+		t.Fatalf("took too long: %s", d)
+	}
+	if v := s.ReasonUnknownKind(); v != UnknownCanceled {
+		t.Fatalf("bad: %s (%s)", v, s.ReasonUnknown())
+	}
+
+	// The solver is usable again after the interrupt
+	s.Reset()
+	s.Assert(x.Gt(zero))
+	if v, err := s.CheckContext(context.Background()); v != True || err != nil {
+		t.Fatalf("bad: %v %v", v, err)
+	}
+}
+
+func TestSolverReasonUnknown(t *testing.T) {
+	config := NewConfig()
+	defer config.Close()
+	config.SetParamValue("timeout", "100")
+
+	ctx := NewContext(config)
+	defer ctx.Close()
+
+	// Create the solver
+	s := ctx.NewSolver()
+	defer s.Close()
+
+	// Same hard query as TestSolverCheckContext
+	x := ctx.Const(ctx.Symbol("x"), ctx.IntSort())
+	y := ctx.Const(ctx.Symbol("y"), ctx.IntSort())
+	z := ctx.Const(ctx.Symbol("z"), ctx.IntSort())
+	zero := ctx.Int(0, ctx.IntSort())
+	s.Assert(x.Gt(zero))
+	s.Assert(y.Gt(zero))
+	s.Assert(z.Gt(zero))
+	s.Assert(x.Mul(x, x).Add(y.Mul(y, y)).Eq(z.Mul(z, z)))
+
+	if v := s.Check(); v != Undef {
+		t.Fatalf("bad: %v", v)
+	}
+	t.Logf("Reason: %s", s.ReasonUnknown())
+	if v := s.ReasonUnknownKind(); v != UnknownTimeout {
+		t.Fatalf("bad: %s", v)
+	}
+}
+
+func TestClassifyReasonUnknown(t *testing.T) {
+	cases := []struct {
+		Input  string
+		Output UnknownKind
+	}{
+		{"timeout", UnknownTimeout},
+		{"max. resource limit exceeded", UnknownTimeout},
+		{"canceled", UnknownCanceled},
+		{"interrupted from keyboard", UnknownCanceled},
+		{"(incomplete (theory arithmetic))", UnknownIncomplete},
+		{"smt tactic failed to show goal to be sat/unsat (incomplete quantifiers)", UnknownIncomplete},
+		{"max. memory exceeded", UnknownMemoryLimit},
+		{"unknown", UnknownOther},
+	}
+
+	for _, tc := range cases {
+		if v := classifyReasonUnknown(tc.Input); v != tc.Output {
+			t.Fatalf("bad: %q: %s", tc.Input, v)
+		}
+	}
+}
+
+func TestSolverSetParams(t *testing.T) {
+	config := NewConfig()
+	defer config.Close()
+	ctx := NewContext(config)
+	defer ctx.Close()
+
+	// Create the solver
+	s := ctx.NewSolver()
+	defer s.Close()
+
+	// The solver describes its parameters
+	d := s.ParamDescrs()
+	defer d.Close()
+	if v := d.Kind("timeout"); v != ParamUint {
+		t.Fatalf("bad: %s", v)
+	}
+
+	p := ctx.NewParams()
+	defer p.Close()
+	p.SetUint("timeout", 1000)
+	if err := s.SetParams(p); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	// Misspelled parameters are errors instead of being ignored
+	misspelled := ctx.NewParams()
+	defer misspelled.Close()
+	misspelled.SetUint("timeuot", 1000)
+	if err := s.SetParams(misspelled); err == nil {
+		t.Fatal("should error")
+	}
+}
@@ -24,3 +24,142 @@ func (c *Context) IntSort() *Sort {
 		rawSort: C.Z3_mk_int_sort(c.raw),
 	}
 }
+
+// RealSort returns the real type.
+func (c *Context) RealSort() *Sort {
+	return &Sort{
+		rawCtx:  c.raw,
+		rawSort: C.Z3_mk_real_sort(c.raw),
+	}
+}
+
+// BitVecSort returns the bit-vector type of the given size in bits. size
+// must be greater than zero.
+func (c *Context) BitVecSort(size uint) *Sort {
+	return &Sort{
+		rawCtx:  c.raw,
+		rawSort: C.Z3_mk_bv_sort(c.raw, C.uint(size)),
+	}
+}
+
+// BVSize returns the size in bits of a bit-vector type.
+//
+// Maps: Z3_get_bv_sort_size
+func (s *Sort) BVSize() uint {
+	return uint(C.Z3_get_bv_sort_size(s.rawCtx, s.rawSort))
+}
+
+// ArraySort returns the array type mapping values of the domain type to
+// values of the range type.
+func (c *Context) ArraySort(domain, rng *Sort) *Sort {
+	return &Sort{
+		rawCtx:  c.raw,
+		rawSort: C.Z3_mk_array_sort(c.raw, domain.rawSort, rng.rawSort),
+	}
+}
+
+// SetSort returns the type of sets of values of the element type. Sets
+// are arrays from the element type to booleans, so the array operations
+// also work on them.
+func (c *Context) SetSort(elem *Sort) *Sort {
+	return &Sort{
+		rawCtx:  c.raw,
+		rawSort: C.Z3_mk_set_sort(c.raw, elem.rawSort),
+	}
+}
+
+// String returns a human-friendly string version of the type.
+func (s *Sort) String() string {
+	return C.GoString(C.Z3_sort_to_string(s.rawCtx, s.rawSort))
+}
+
+// StringSort returns the string type. Strings are sequences of
+// characters.
+func (c *Context) StringSort() *Sort {
+	return &Sort{
+		rawCtx:  c.raw,
+		rawSort: C.Z3_mk_string_sort(c.raw),
+	}
+}
+
+// SeqSort returns the type of sequences with elements of the given type.
+func (c *Context) SeqSort(elem *Sort) *Sort {
+	return &Sort{
+		rawCtx:  c.raw,
+		rawSort: C.Z3_mk_seq_sort(c.raw, elem.rawSort),
+	}
+}
+
+// ReSort returns the type of regular expressions over the given
+// sequence type, such as StringSort.
+func (c *Context) ReSort(seq *Sort) *Sort {
+	return &Sort{
+		rawCtx:  c.raw,
+		rawSort: C.Z3_mk_re_sort(c.raw, seq.rawSort),
+	}
+}
+
+// FPSort returns the IEEE floating-point type with the given number of
+// exponent and significand bits. sbits includes the hidden bit, so a
+// double precision float is FPSort(11, 53).
+func (c *Context) FPSort(ebits, sbits uint) *Sort {
+	return &Sort{
+		rawCtx:  c.raw,
+		rawSort: C.Z3_mk_fpa_sort(c.raw, C.uint(ebits), C.uint(sbits)),
+	}
+}
+
+// FPSort16 returns the IEEE half precision floating-point type.
+func (c *Context) FPSort16() *Sort {
+	return &Sort{
+		rawCtx:  c.raw,
+		rawSort: C.Z3_mk_fpa_sort_16(c.raw),
+	}
+}
+
+// FPSort32 returns the IEEE single precision floating-point type.
+func (c *Context) FPSort32() *Sort {
+	return &Sort{
+		rawCtx:  c.raw,
+		rawSort: C.Z3_mk_fpa_sort_32(c.raw),
+	}
+}
+
+// FPSort64 returns the IEEE double precision floating-point type.
+func (c *Context) FPSort64() *Sort {
+	return &Sort{
+		rawCtx:  c.raw,
+		rawSort: C.Z3_mk_fpa_sort_64(c.raw),
+	}
+}
+
+// FPSort128 returns the IEEE quadruple precision floating-point type.
+func (c *Context) FPSort128() *Sort {
+	return &Sort{
+		rawCtx:  c.raw,
+		rawSort: C.Z3_mk_fpa_sort_128(c.raw),
+	}
+}
+
+// RoundingModeSort returns the type of floating-point rounding modes.
+func (c *Context) RoundingModeSort() *Sort {
+	return &Sort{
+		rawCtx:  c.raw,
+		rawSort: C.Z3_mk_fpa_rounding_mode_sort(c.raw),
+	}
+}
+
+// FPEBits returns the number of exponent bits of a floating-point type.
+//
+// Maps: Z3_fpa_get_ebits
+func (s *Sort) FPEBits() uint {
+	return uint(C.Z3_fpa_get_ebits(s.rawCtx, s.rawSort))
+}
+
+// FPSBits returns the number of significand bits, including the hidden
+// bit, of a floating-point type.
+//
+// Maps: Z3_fpa_get_sbits
+func (s *Sort) FPSBits() uint {
+	return uint(C.Z3_fpa_get_sbits(s.rawCtx, s.rawSort))
+}
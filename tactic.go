@@ -0,0 +1,230 @@
+package z3
+
+import (
+	"time"
+	"unsafe"
+)
+
+// #include <stdlib.h>
+// #include "go-z3.h"
+import "C"
+
+// Tactic transforms a goal into zero or more subgoals. Tactics are
+// combined with the combinators on this type, such as AndThen and
+// OrElse, to build custom preprocessing and solving strategies.
+//
+// It is created via Tactic on Context. When the tactic is no longer
+// needed, the Close method must be called. Tactics built from other
+// tactics hold their own references, so closing the parts doesn't affect
+// the combined tactic.
+type Tactic struct {
+	rawCtx    C.Z3_context
+	rawTactic C.Z3_tactic
+}
+
+// Tactic creates the built-in tactic with the given name, such as
+// "simplify" or "bit-blast". An error is returned if there is no tactic
+// with that name.
+//
+// Maps: Z3_mk_tactic
+func (c *Context) Tactic(name string) (*Tactic, error) {
+	cs := C.CString(name)
+	defer C.free(unsafe.Pointer(cs))
+
+	var raw C.Z3_tactic
+	if err := withError(c.raw, func() {
+		raw = C.Z3_mk_tactic(c.raw, cs)
+	}); err != nil {
+		return nil, err
+	}
+
+	return newTactic(c.raw, raw), nil
+}
+
+// Close frees the memory associated with this.
+func (t *Tactic) Close() error {
+	C.Z3_tactic_dec_ref(t.rawCtx, t.rawTactic)
+	return nil
+}
+
+// Help returns a description of the tactic and the parameters it
+// accepts.
+//
+// Maps to: Z3_tactic_get_help
+func (t *Tactic) Help() string {
+	return C.GoString(C.Z3_tactic_get_help(t.rawCtx, t.rawTactic))
+}
+
+// Apply applies the tactic to the goal. An error is returned if the
+// tactic fails, for example when a FailIf probe is true.
+//
+// Maps to: Z3_tactic_apply
+func (t *Tactic) Apply(g *Goal) (*ApplyResult, error) {
+	var raw C.Z3_apply_result
+	if err := withError(t.rawCtx, func() {
+		raw = C.Z3_tactic_apply(t.rawCtx, t.rawTactic, g.rawGoal)
+	}); err != nil {
+		return nil, err
+	}
+
+	C.Z3_apply_result_inc_ref(t.rawCtx, raw)
+	return &ApplyResult{
+		rawCtx:         t.rawCtx,
+		rawApplyResult: raw,
+	}, nil
+}
+
+//-------------------------------------------------------------------
+// Combinators
+//-------------------------------------------------------------------
+
+// AndThen creates a tactic that applies t and then t2 to every subgoal
+// produced by t.
+//
+// Maps to: Z3_tactic_and_then
+func (t *Tactic) AndThen(t2 *Tactic) *Tactic {
+	return newTactic(t.rawCtx, C.Z3_tactic_and_then(t.rawCtx, t.rawTactic, t2.rawTactic))
+}
+
+// OrElse creates a tactic that applies t and, if it fails, applies t2
+// instead.
+//
+// Maps to: Z3_tactic_or_else
+func (t *Tactic) OrElse(t2 *Tactic) *Tactic {
+	return newTactic(t.rawCtx, C.Z3_tactic_or_else(t.rawCtx, t.rawTactic, t2.rawTactic))
+}
+
+// Repeat creates a tactic that applies t to a goal and then again to
+// every subgoal, until no subgoal is modified or max iterations are
+// reached.
+//
+// Maps to: Z3_tactic_repeat
+func (t *Tactic) Repeat(max uint) *Tactic {
+	return newTactic(t.rawCtx, C.Z3_tactic_repeat(t.rawCtx, t.rawTactic, C.uint(max)))
+}
+
+// TryFor creates a tactic that applies t, failing if it doesn't finish
+// within the given duration. The duration has millisecond precision.
+//
+// Maps to: Z3_tactic_try_for
+func (t *Tactic) TryFor(d time.Duration) *Tactic {
+	ms := d / time.Millisecond
+	return newTactic(t.rawCtx, C.Z3_tactic_try_for(t.rawCtx, t.rawTactic, C.uint(ms)))
+}
+
+// When creates a tactic that applies t if the probe p is true for the
+// goal and otherwise leaves the goal unchanged.
+//
+// Maps to: Z3_tactic_when
+func (t *Tactic) When(p *Probe) *Tactic {
+	return newTactic(t.rawCtx, C.Z3_tactic_when(t.rawCtx, p.rawProbe, t.rawTactic))
+}
+
+// UsingParams creates a tactic that applies t with the given parameters.
+// An error is returned if t doesn't accept the parameters; see
+// ParamDescrs for the ones it does.
+//
+// Maps to: Z3_tactic_using_params
+func (t *Tactic) UsingParams(p *Params) (*Tactic, error) {
+	var raw C.Z3_tactic
+	if err := withError(t.rawCtx, func() {
+		raw = C.Z3_tactic_using_params(t.rawCtx, t.rawTactic, p.rawParams)
+	}); err != nil {
+		return nil, err
+	}
+
+	return newTactic(t.rawCtx, raw), nil
+}
+
+// ParamDescrs returns the descriptions of the parameters the tactic
+// accepts.
+//
+// Maps to: Z3_tactic_get_param_descrs
+func (t *Tactic) ParamDescrs() *ParamDescrs {
+	return newParamDescrs(t.rawCtx, C.Z3_tactic_get_param_descrs(t.rawCtx, t.rawTactic))
+}
+
+// ParOr creates a tactic that applies the tactics in parallel and uses
+// the result of the first one to succeed.
+//
+// Maps: Z3_tactic_par_or
+func (c *Context) ParOr(ts ...*Tactic) *Tactic {
+	raws := make([]C.Z3_tactic, len(ts))
+	for i, t := range ts {
+		raws[i] = t.rawTactic
+	}
+
+	var rawTactics *C.Z3_tactic
+	if len(raws) > 0 {
+		rawTactics = (*C.Z3_tactic)(unsafe.Pointer(&raws[0]))
+	}
+
+	return newTactic(c.raw, C.Z3_tactic_par_or(c.raw, C.uint(len(raws)), rawTactics))
+}
+
+// Cond creates a tactic that applies t1 if the probe p is true for the
+// goal and t2 otherwise.
+//
+// Maps: Z3_tactic_cond
+func (c *Context) Cond(p *Probe, t1, t2 *Tactic) *Tactic {
+	return newTactic(c.raw, C.Z3_tactic_cond(c.raw, p.rawProbe, t1.rawTactic, t2.rawTactic))
+}
+
+// FailIf creates a tactic that fails if the probe p is true for the goal
+// and otherwise leaves the goal unchanged.
+//
+// Maps: Z3_tactic_fail_if
+func (c *Context) FailIf(p *Probe) *Tactic {
+	return newTactic(c.raw, C.Z3_tactic_fail_if(c.raw, p.rawProbe))
+}
+
+// newTactic wraps a raw tactic, taking a reference to it.
+func newTactic(rawCtx C.Z3_context, raw C.Z3_tactic) *Tactic {
+	C.Z3_tactic_inc_ref(rawCtx, raw)
+	return &Tactic{
+		rawCtx:    rawCtx,
+		rawTactic: raw,
+	}
+}
+
+//-------------------------------------------------------------------
+// Apply Results
+//-------------------------------------------------------------------
+
+// ApplyResult is the result of applying a tactic to a goal: the list of
+// subgoals the goal was transformed into. The original goal is
+// satisfiable if any subgoal is satisfiable, and Goal.ConvertModel turns
+// a model of a subgoal into a model of the original goal.
+//
+// When the result is no longer needed, the Close method must be called.
+type ApplyResult struct {
+	rawCtx         C.Z3_context
+	rawApplyResult C.Z3_apply_result
+}
+
+// Close frees the memory associated with this.
+func (r *ApplyResult) Close() error {
+	C.Z3_apply_result_dec_ref(r.rawCtx, r.rawApplyResult)
+	return nil
+}
+
+// String returns a human-friendly string version of the subgoals.
+func (r *ApplyResult) String() string {
+	return C.GoString(C.Z3_apply_result_to_string(r.rawCtx, r.rawApplyResult))
+}
+
+// NumSubgoals returns the number of subgoals.
+//
+// Maps to: Z3_apply_result_get_num_subgoals
+func (r *ApplyResult) NumSubgoals() uint {
+	return uint(C.Z3_apply_result_get_num_subgoals(r.rawCtx, r.rawApplyResult))
+}
+
+// Subgoal returns the subgoal at the given index, which must be less
+// than NumSubgoals. The returned goal must be closed when it is no longer
+// needed.
+//
+// Maps to: Z3_apply_result_get_subgoal
+func (r *ApplyResult) Subgoal(idx uint) *Goal {
+	return newGoal(r.rawCtx, C.Z3_apply_result_get_subgoal(r.rawCtx, r.rawApplyResult, C.uint(idx)))
+}
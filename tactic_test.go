@@ -0,0 +1,228 @@
+package z3
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestContextTactic(t *testing.T) {
+	config := NewConfig()
+	defer config.Close()
+	ctx := NewContext(config)
+	defer ctx.Close()
+
+	tac, err := ctx.Tactic("simplify")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer tac.Close()
+
+	if v := tac.Help(); v == "" {
+		t.Fatal("should have help")
+	}
+
+	// Unknown tactics are errors
+	if _, err := ctx.Tactic("no-such-tactic"); err == nil {
+		t.Fatal("should error")
+	}
+}
+
+func TestTacticApply(t *testing.T) {
+	config := NewConfig()
+	defer config.Close()
+	ctx := NewContext(config)
+	defer ctx.Close()
+
+	// x = y + 1, y > 3
+	x := ctx.Const(ctx.Symbol("x"), ctx.IntSort())
+	y := ctx.Const(ctx.Symbol("y"), ctx.IntSort())
+	g := ctx.NewGoal(true, false, false)
+	defer g.Close()
+	g.Assert(x.Eq(y.Add(ctx.Int(1, ctx.IntSort()))))
+	g.Assert(y.Gt(ctx.Int(3, ctx.IntSort())))
+
+	// Eliminate x
+	simplify, err := ctx.Tactic("simplify")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer simplify.Close()
+	solveEqs, err := ctx.Tactic("solve-eqs")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer solveEqs.Close()
+	tac := simplify.AndThen(solveEqs)
+	defer tac.Close()
+
+	r, err := tac.Apply(g)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer r.Close()
+
+	if v := r.NumSubgoals(); v != 1 {
+		t.Fatalf("bad: %s", r)
+	}
+	sub := r.Subgoal(0)
+	defer sub.Close()
+	if v := sub.String(); strings.Contains(v, "x") || sub.Depth() == 0 {
+		t.Fatalf("bad: %s", v)
+	}
+
+	// Solve the subgoal
+	s := ctx.NewSolver()
+	defer s.Close()
+	for _, f := range sub.Formulas() {
+		s.Assert(f)
+	}
+	if v := s.Check(); v != True {
+		t.Fatalf("bad: %v", v)
+	}
+	m := s.Model()
+	defer m.Close()
+
+	// The converted model has a value for x again
+	converted := sub.ConvertModel(m)
+	defer converted.Close()
+	xv := converted.Eval(x).Int()
+	yv := converted.Eval(y).Int()
+	if yv <= 3 || xv != yv+1 {
+		t.Fatalf("bad: %d %d", xv, yv)
+	}
+}
+
+func TestTacticCombinators(t *testing.T) {
+	config := NewConfig()
+	defer config.Close()
+	ctx := NewContext(config)
+	defer ctx.Close()
+
+	// A bit-vector goal
+	x := ctx.Const(ctx.Symbol("x"), ctx.BitVecSort(8))
+	g := ctx.NewGoal(true, false, false)
+	defer g.Close()
+	g.Assert(x.BVUGT(ctx.BitVec(3, 8)))
+
+	isQFBV, err := ctx.Probe("is-qfbv")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer isQFBV.Close()
+	skip, err := ctx.Tactic("skip")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer skip.Close()
+	bitBlast, err := ctx.Tactic("bit-blast")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer bitBlast.Close()
+	simplify, err := ctx.Tactic("simplify")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer simplify.Close()
+
+	// FailIf fails on bit-vector goals, so OrElse falls back to skip
+	failIf := ctx.FailIf(isQFBV)
+	defer failIf.Close()
+	if _, err := failIf.Apply(g); err == nil {
+		t.Fatal("should fail")
+	}
+	orElse := failIf.OrElse(skip)
+	defer orElse.Close()
+	if _, err := orElse.Apply(g); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	// Cond and When pick bit-blasting for bit-vector goals
+	bitBlastParams := ctx.NewParams()
+	defer bitBlastParams.Close()
+	bitBlastParams.SetBool("blast_full", true)
+	fullBitBlast, err := bitBlast.UsingParams(bitBlastParams)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer fullBitBlast.Close()
+	for _, tac := range []*Tactic{
+		ctx.Cond(isQFBV, simplify.AndThen(bitBlast), skip),
+		simplify.AndThen(fullBitBlast.When(isQFBV)),
+		ctx.ParOr(failIf, simplify.AndThen(bitBlast)).Repeat(2).TryFor(time.Minute),
+	} {
+		defer tac.Close()
+
+		r, err := tac.Apply(g)
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+		defer r.Close()
+
+		if v := r.String(); strings.Contains(v, "bvugt") || strings.Contains(v, "bvule") {
+			t.Fatalf("bad: %s", v)
+		}
+	}
+}
+
+func TestNewSolverFromTactic(t *testing.T) {
+	config := NewConfig()
+	defer config.Close()
+	ctx := NewContext(config)
+	defer ctx.Close()
+
+	tac, err := ctx.Tactic("qfbv")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer tac.Close()
+
+	// Create the solver
+	s := ctx.NewSolverFromTactic(tac)
+	defer s.Close()
+
+	// x * 3 = 21 over bytes
+	x := ctx.Const(ctx.Symbol("x"), ctx.BitVecSort(8))
+	s.Assert(x.BVMul(ctx.BitVec(3, 8)).Eq(ctx.BitVec(21, 8)))
+
+	if v := s.Check(); v != True {
+		t.Fatalf("bad: %v", v)
+	}
+
+	// Get the model
+	m := s.Model()
+	defer m.Close()
+
+	if v, ok := m.Eval(x).Uint64(); !ok || v != 7 {
+		t.Fatalf("bad: %d", v)
+	}
+}
+
+func TestTacticUsingParams(t *testing.T) {
+	config := NewConfig()
+	defer config.Close()
+	ctx := NewContext(config)
+	defer ctx.Close()
+
+	tac, err := ctx.Tactic("simplify")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer tac.Close()
+
+	// The tactic describes its parameters
+	d := tac.ParamDescrs()
+	defer d.Close()
+	if v := d.Kind("som"); v != ParamBool {
+		t.Fatalf("bad: %s", v)
+	}
+
+	// Misspelled parameters are errors
+	p := ctx.NewParams()
+	defer p.Close()
+	p.SetBool("smo", true)
+	if _, err := tac.UsingParams(p); err == nil {
+		t.Fatal("should error")
+	}
+}
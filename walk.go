@@ -0,0 +1,134 @@
+package z3
+
+import (
+	"unsafe"
+)
+
+// #include "go-z3.h"
+import "C"
+
+// Visitor is called by Walk for every AST node. If the result visitor w
+// is not nil, Walk visits each of the children of the node with w,
+// followed by a call of w.Visit(nil).
+//
+// This mirrors ast.Visitor in the go/ast package.
+type Visitor interface {
+	Visit(a *AST) (w Visitor)
+}
+
+// Walk traverses the AST a in depth-first order. It starts by calling
+// v.Visit(a). If the visitor w returned by v.Visit(a) is not nil, Walk
+// is invoked recursively with visitor w for each of the children of a,
+// followed by a call of w.Visit(nil).
+//
+// The children of an application are its arguments and the child of a
+// quantifier is its body. Numerals, constants and bound variables have
+// no children.
+//
+// Z3 terms are DAGs: structurally equal subterms are shared. Walk visits
+// every distinct node only once, identified by its ID, so terms with
+// heavy sharing are walked in time linear in the number of distinct
+// nodes rather than the size of the tree they unfold to.
+//
+// This doesn't map to any specific Z3 API.
+func Walk(a *AST, v Visitor) {
+	walk(a, v, make(map[uint]struct{}))
+}
+
+func walk(a *AST, v Visitor, seen map[uint]struct{}) {
+	id := a.ID()
+	if _, ok := seen[id]; ok {
+		return
+	}
+	seen[id] = struct{}{}
+
+	if v = v.Visit(a); v == nil {
+		return
+	}
+
+	for _, child := range astChildren(a) {
+		walk(child, v, seen)
+	}
+
+	v.Visit(nil)
+}
+
+// inspector implements Visitor for Inspect.
+type inspector func(*AST) bool
+
+func (f inspector) Visit(a *AST) Visitor {
+	if f(a) {
+		return f
+	}
+
+	return nil
+}
+
+// Inspect traverses the AST a like Walk. It starts by calling f(a),
+// and if f returns true, Inspect is invoked recursively with f for each
+// of the children of a, followed by a call of f(nil).
+//
+// This mirrors ast.Inspect in the go/ast package.
+func Inspect(a *AST, f func(*AST) bool) {
+	Walk(a, inspector(f))
+}
+
+// Rewrite rebuilds the AST a bottom-up. Every distinct node is rebuilt
+// from its rewritten children and then passed to f, and the result of f
+// replaces the node. f should return its argument to keep a node
+// unchanged. The result must have the same type as the argument.
+//
+// Like Walk, shared subterms are rewritten only once, identified by
+// their ID, and the rewritten terms are shared again in the result.
+//
+// Errors, such as f returning a term of the wrong type, are recorded on
+// the Context and can be checked with Context.Err.
+//
+// Maps: Z3_update_term
+func Rewrite(a *AST, f func(*AST) *AST) *AST {
+	return rewrite(a, f, make(map[uint]*AST))
+}
+
+func rewrite(a *AST, f func(*AST) *AST, done map[uint]*AST) *AST {
+	id := a.ID()
+	if result, ok := done[id]; ok {
+		return result
+	}
+
+	children := astChildren(a)
+	changed := false
+	raws := make([]C.Z3_ast, len(children))
+	for i, child := range children {
+		v := rewrite(child, f, done)
+		changed = changed || v.ID() != child.ID()
+		raws[i] = v.rawAST
+	}
+
+	result := a
+	if changed {
+		result = &AST{
+			rawCtx: a.rawCtx,
+			rawAST: C.Z3_update_term(
+				a.rawCtx, a.rawAST,
+				C.uint(len(raws)),
+				(*C.Z3_ast)(unsafe.Pointer(&raws[0]))),
+		}
+	}
+
+	result = f(result)
+	done[id] = result
+	return result
+}
+
+// astChildren returns the children of a node as used by Walk and
+// Rewrite.
+func astChildren(a *AST) []*AST {
+	switch a.Kind() {
+	case ASTApp:
+		return a.Args()
+	case ASTQuantifier:
+		return []*AST{a.QuantifierBody()}
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,174 @@
+package z3
+
+import (
+	"testing"
+)
+
+// constCollector is a Visitor that collects the names of constants.
+type constCollector struct {
+	names  []string
+	visits int
+}
+
+func (c *constCollector) Visit(a *AST) Visitor {
+	if a == nil {
+		return nil
+	}
+
+	c.visits++
+	if a.IsApp() && a.NumArgs() == 0 && !a.IsNumeral() &&
+		a.Decl().Kind() == OpUninterpreted {
+		c.names = append(c.names, a.Decl().Name().String())
+	}
+
+	return c
+}
+
+func TestWalk(t *testing.T) {
+	config := NewConfig()
+	defer config.Close()
+	ctx := NewContext(config)
+	defer ctx.Close()
+
+	// t = x + y, then t = t * t 100 times. The tree unfolds to 2^100
+	// leaves but there are only about 100 distinct nodes.
+	x := ctx.Const(ctx.Symbol("x"), ctx.IntSort())
+	y := ctx.Const(ctx.Symbol("y"), ctx.IntSort())
+	term := x.Add(y)
+	for i := 0; i < 100; i++ {
+		term = term.Mul(term)
+	}
+
+	var c constCollector
+	Walk(term, &c)
+
+	if len(c.names) != 2 || c.names[0] != "x" || c.names[1] != "y" {
+		t.Fatalf("bad: %v", c.names)
+	}
+	if c.visits != 103 {
+		t.Fatalf("bad: %d", c.visits)
+	}
+}
+
+func TestInspect(t *testing.T) {
+	config := NewConfig()
+	defer config.Close()
+	ctx := NewContext(config)
+	defer ctx.Close()
+
+	// (x + 1 > 0) and forall y. y > x
+	x := ctx.Const(ctx.Symbol("x"), ctx.IntSort())
+	y := ctx.Const(ctx.Symbol("y"), ctx.IntSort())
+	zero := ctx.Int(0, ctx.IntSort())
+	one := ctx.Int(1, ctx.IntSort())
+	raw := x.Add(one).Gt(zero).And(ctx.ForAll([]*AST{y}, y.Gt(x)))
+
+	// Count numerals and bound variables, skipping the quantifier
+	var numerals, vars int
+	Inspect(raw, func(a *AST) bool {
+		if a == nil {
+			return false
+		}
+
+		switch a.Kind() {
+		case ASTNumeral:
+			numerals++
+		case ASTVar:
+			vars++
+		case ASTQuantifier:
+			return false
+		}
+
+		return true
+	})
+	if numerals != 2 || vars != 0 {
+		t.Fatalf("bad: %d %d", numerals, vars)
+	}
+
+	// Now including the quantifier body
+	vars = 0
+	Inspect(raw, func(a *AST) bool {
+		if a != nil && a.Kind() == ASTVar {
+			vars++
+		}
+
+		return a != nil
+	})
+	if vars != 1 {
+		t.Fatalf("bad: %d", vars)
+	}
+}
+
+func TestRewrite(t *testing.T) {
+	config := NewConfig()
+	defer config.Close()
+	ctx := NewContext(config)
+	defer ctx.Close()
+
+	// Replace x with y + 1 everywhere, including under a quantifier
+	x := ctx.Const(ctx.Symbol("x"), ctx.IntSort())
+	y := ctx.Const(ctx.Symbol("y"), ctx.IntSort())
+	z := ctx.Const(ctx.Symbol("z"), ctx.IntSort())
+	one := ctx.Int(1, ctx.IntSort())
+	raw := x.Mul(x).Gt(z).And(ctx.Exists([]*AST{z}, z.Lt(x)))
+
+	var calls int
+	actual := Rewrite(raw, func(a *AST) *AST {
+		calls++
+		if a.ID() == x.ID() {
+			return y.Add(one)
+		}
+
+		return a
+	})
+
+	expected := "(and (> (* (+ y 1) (+ y 1)) z) (exists ((z Int)) (< z (+ y 1))))"
+	if v := actual.String(); v != expected {
+		t.Fatalf("bad:\n%s", v)
+	}
+
+	// x, x * x, z, x * x > z, the bound variable, z < x, exists and and
+	if calls != 8 {
+		t.Fatalf("bad: %d", calls)
+	}
+
+	// Unchanged terms are returned as is
+	if v := Rewrite(raw, func(a *AST) *AST { return a }); v.ID() != raw.ID() {
+		t.Fatalf("bad: %s", v)
+	}
+	if err := ctx.Err(); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
+
+func TestRewriteShared(t *testing.T) {
+	config := NewConfig()
+	defer config.Close()
+	ctx := NewContext(config)
+	defer ctx.Close()
+
+	// A term with 2^100 leaves
+	x := ctx.Const(ctx.Symbol("x"), ctx.IntSort())
+	y := ctx.Const(ctx.Symbol("y"), ctx.IntSort())
+	term := x
+	for i := 0; i < 100; i++ {
+		term = term.Add(term)
+	}
+
+	actual := Rewrite(term, func(a *AST) *AST {
+		if a.ID() == x.ID() {
+			return y
+		}
+
+		return a
+	})
+
+	// Rebuilding the same term from y gives the same shared node
+	expected := y
+	for i := 0; i < 100; i++ {
+		expected = expected.Add(expected)
+	}
+	if actual.ID() != expected.ID() {
+		t.Fatalf("bad: %d %d", actual.ID(), expected.ID())
+	}
+}
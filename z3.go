@@ -6,8 +6,10 @@
 //
 // The most foreign thing to Go programmers will be error handling. Rather
 // than return the `error` type from almost every function, the z3 package
-// mimics Z3's API by requiring you to set an error handler callback. This
-// error handler will be invoked whenenver an error occurs. See
+// mimics Z3's API: errors are recorded on the Context as they occur. You
+// can check for them with Context.Err, which returns an ordinary Go error
+// carrying the ErrorCode and message, or you can set an error handler
+// callback that is invoked whenever an error occurs. See Context.Err,
 // ErrorHandler and Context.SetErrorHandler for more information.
 package z3
 